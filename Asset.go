@@ -0,0 +1,94 @@
+package ygopro_data
+
+import (
+	"sort"
+	"strconv"
+)
+
+var assetExtensions = []string{".jpg", ".png"}
+
+// 卡片的图片路径，不存在的图片为空字符串
+type CardAssets struct {
+	Picture   string
+	Field     string
+	Thumbnail string
+}
+
+// 查找卡片的图片，卡片自己没有图片时使用原卡（Alias）的图片
+// 与 Card.Script 相同，只回退一层，不跟随原卡自己的 Alias
+func (environment *Environment) Assets(id int) CardAssets {
+	assets := environment.ownAssets(id)
+	if card, exist := environment.GetCard(id); exist && card.IsAlias() {
+		alias := environment.ownAssets(card.Alias)
+		if len(assets.Picture) == 0 {
+			assets.Picture = alias.Picture
+		}
+		if len(assets.Field) == 0 {
+			assets.Field = alias.Field
+		}
+		if len(assets.Thumbnail) == 0 {
+			assets.Thumbnail = alias.Thumbnail
+		}
+	}
+	return assets
+}
+
+func (environment *Environment) ownAssets(id int) CardAssets {
+	options := environment.options
+	return CardAssets{
+		Picture:   environment.findAsset(options.PicPath, id),
+		Field:     environment.findAsset(options.FieldPicPath, id),
+		Thumbnail: environment.findAsset(options.ThumbnailPath, id),
+	}
+}
+
+func (card *Card) Assets() CardAssets {
+	return GetEnvironment(card.Locale).Assets(card.Id)
+}
+
+func (environment *Environment) findAsset(directory string, id int) string {
+	if len(directory) == 0 {
+		return ""
+	}
+	options := environment.options
+	for _, extension := range assetExtensions {
+		assetPath := options.join(directory, strconv.Itoa(id)+extension)
+		if info, err := options.stat(assetPath); err == nil && !info.IsDir() {
+			return assetPath
+		}
+	}
+	return ""
+}
+
+// 缺少图片的卡片；只检查已配置的目录，场地卡图只检查场地魔法
+type MissingAsset struct {
+	Card      Card
+	Picture   bool
+	Field     bool
+	Thumbnail bool
+}
+
+// 检查环境中的所有卡片，尚未调用 LoadAllCards 时先读取全部卡片
+func (environment *Environment) MissingAssets() []MissingAsset {
+	if !environment.cardsLoaded {
+		environment.LoadAllCards()
+	}
+	options := environment.options
+	cards := make([]Card, 0, len(environment.Cards))
+	for _, card := range environment.Cards {
+		cards = append(cards, card)
+	}
+	var missing []MissingAsset
+	for _, card := range cards {
+		assets := environment.Assets(card.Id)
+		report := MissingAsset{Card: card}
+		report.Picture = len(options.PicPath) > 0 && len(assets.Picture) == 0
+		report.Field = len(options.FieldPicPath) > 0 && card.HasType(TYPE_SPELL) && card.HasType(TYPE_FIELD) && len(assets.Field) == 0
+		report.Thumbnail = len(options.ThumbnailPath) > 0 && len(assets.Thumbnail) == 0
+		if report.Picture || report.Field || report.Thumbnail {
+			missing = append(missing, report)
+		}
+	}
+	sort.Slice(missing, func(i, j int) bool { return missing[i].Card.Id < missing[j].Card.Id })
+	return missing
+}
@@ -8,20 +8,50 @@ import (
 type Card struct {
 	Locale                    string
 	Id, Ot, Alias             int
-	Setcode, Type, Category   int64
+	Setcode, Type             int64
+	Category                  CardCategory
 	Name, Desc                string
 	originLevel               int64
 	Race, Attribute, Atk, Def int
+
+	// texts 表中的 str1..str16，效果发动时的提示文本
+	Strings [16]string
+
+	// 卡片来源的数据库层名称
+	Source string
 }
 
-func createCardFromData(locale string, rows *sql.Rows) (card Card) {
-	var str1, str2, str3, str4, str5, str6, str7, str8 string
-	var str9, str10, str11, str12, str13, str14, str15, str16 string
-	rows.Scan(&card.Id, &card.Ot, &card.Alias, &card.Setcode, &card.Type, &card.Atk, &card.Def, &card.originLevel, &card.Race, &card.Attribute, &card.Category, &card.Id, &card.Name, &card.Desc, &str1, &str2, &str3, &str4, &str5, &str6, &str7, &str8, &str9, &str10, &str11, &str12, &str13, &str14, &str15, &str16)
+// 列的顺序由 detectSchema 生成的查询语句决定
+func createCardFromData(locale string, rows *sql.Rows) (card Card, err error) {
+	strs := card.Strings[:]
+	err = rows.Scan(&card.Id, &card.Ot, &card.Alias, &card.Setcode, &card.Type, &card.Atk, &card.Def, &card.originLevel, &card.Race, &card.Attribute, &card.Category, &card.Name, &card.Desc,
+		&strs[0], &strs[1], &strs[2], &strs[3], &strs[4], &strs[5], &strs[6], &strs[7],
+		&strs[8], &strs[9], &strs[10], &strs[11], &strs[12], &strs[13], &strs[14], &strs[15])
 	card.Locale = locale
 	return
 }
 
+// 第 index 条提示文本（0-15），对应脚本中的 aux.Stringid(id, index)
+func (card *Card) HintString(index int) string {
+	if index < 0 || index >= len(card.Strings) {
+		return ""
+	}
+	return card.Strings[index]
+}
+
+// 解析效果描述代码：不小于 10000 时为 卡号*16+序号，否则为 !system 字符串
+func (environment *Environment) Description(code int64) (string, bool) {
+	if code < 10000 {
+		return environment.SystemString(code)
+	}
+	card, exist := environment.GetCard(int(code >> 4))
+	if !exist {
+		return "", false
+	}
+	text := card.HintString(int(code & 0xf))
+	return text, len(text) > 0
+}
+
 func (card *Card) IsAlias() bool {
 	return card.Alias > 0
 }
@@ -35,19 +65,69 @@ func (card *Card) IsTcg() bool {
 }
 
 func (card *Card) IsEx() bool {
-	return card.IsType("synchro") || card.IsType("xyz") || card.IsType("fusion") || card.IsType("link")
+	return card.CardType().IsEx()
 }
 
+func (card Card) HasType(cardType CardType) bool {
+	return card.CardType().Has(cardType)
+}
+
+func (card Card) HasRace(race Race) bool {
+	return Race(card.Race)&race > 0
+}
+
+func (card Card) HasAttribute(attribute Attribute) bool {
+	return Attribute(card.Attribute)&attribute > 0
+}
+
+// originLevel 的布局：低 8 位为等级/阶级/连接数，16-23 位为右刻度，24-31 位为左刻度
+// 以下方法对不适用的卡片返回 -1
 func (card *Card) Level() int {
-	return int(card.originLevel % 65536)
+	if !card.HasType(TYPE_MONSTER) || card.HasType(TYPE_XYZ|TYPE_LINK) {
+		return -1
+	}
+	return int(card.originLevel & 0xff)
 }
 
-func (card *Card) PendulumScale() int {
-	if card.IsType("pendulum") {
-		return int((card.originLevel - card.originLevel%65536) / 65536 / 257);
-	} else {
+func (card *Card) Rank() int {
+	if !card.HasType(TYPE_XYZ) {
+		return -1
+	}
+	return int(card.originLevel & 0xff)
+}
+
+func (card *Card) LinkRating() int {
+	if !card.HasType(TYPE_LINK) {
+		return -1
+	}
+	return int(card.originLevel & 0xff)
+}
+
+func (card *Card) LeftScale() int {
+	if !card.HasType(TYPE_PENDULUM) {
+		return -1
+	}
+	return int(card.originLevel >> 24 & 0xff)
+}
+
+func (card *Card) RightScale() int {
+	if !card.HasType(TYPE_PENDULUM) {
 		return -1
 	}
+	return int(card.originLevel >> 16 & 0xff)
+}
+
+// 设置等级、阶级或连接数，保留灵摆刻度
+func (card *Card) SetLevel(level int) {
+	card.originLevel = card.originLevel&^0xff | int64(level&0xff)
+}
+
+func (card *Card) SetScales(left, right int) {
+	card.originLevel = card.originLevel&0xff | int64(left&0xff)<<24 | int64(right&0xff)<<16
+}
+
+func (card *Card) PendulumScale() int {
+	return card.LeftScale()
 }
 
 func (card *Card) LinkMarkers() (markers [9]int) {
@@ -60,7 +140,40 @@ func (card *Card) LinkMarkers() (markers [9]int) {
 }
 
 func (card *Card) LinkNumber() int {
-	return card.Level()
+	return card.LinkRating()
+}
+
+// Setcode 最多包含 4 个 16 位系列代码，低 12 位为系列，高 4 位为子系列
+func (card *Card) SetCodes() []int64 {
+	var codes []int64
+	for setcode := uint64(card.Setcode); setcode > 0; setcode >>= 16 {
+		if code := int64(setcode & 0xffff); code > 0 {
+			codes = append(codes, code)
+		}
+	}
+	return codes
+}
+
+// 与游戏核心的 is_set_card 相同：系列相同，且卡片的子系列包含所查询的子系列
+func (card *Card) IsSetCard(code int64) bool {
+	setType := code & 0xfff
+	setSubType := code & 0xf000
+	for _, cardCode := range card.SetCodes() {
+		if cardCode&0xfff == setType && cardCode&setSubType == setSubType {
+			return true
+		}
+	}
+	return false
+}
+
+func (card *Card) Sets(environment *Environment) []Set {
+	var sets []Set
+	for _, set := range environment.Sets {
+		if card.IsSetCard(set.Code) {
+			sets = append(sets, set)
+		}
+	}
+	return sets
 }
 
 func (card Card) String() string {
@@ -0,0 +1,42 @@
+package ygopro_data
+
+import "testing"
+
+func TestCardLevelAndScales(t *testing.T) {
+	tests := []struct {
+		name                           string
+		cardType                       CardType
+		originLevel                    int64
+		level, rank, link, left, right int
+	}{
+		{"normal", TYPE_MONSTER | TYPE_NORMAL, 4, 4, -1, -1, -1, -1},
+		{"effect level 12", TYPE_MONSTER | TYPE_EFFECT, 12, 12, -1, -1, -1, -1},
+		{"xyz", TYPE_MONSTER | TYPE_EFFECT | TYPE_XYZ, 4, -1, 4, -1, -1, -1},
+		{"link", TYPE_MONSTER | TYPE_EFFECT | TYPE_LINK, 3, -1, -1, 3, -1, -1},
+		{"pendulum equal scales", TYPE_MONSTER | TYPE_EFFECT | TYPE_PENDULUM, 0x08080007, 7, -1, -1, 8, 8},
+		{"pendulum unequal scales", TYPE_MONSTER | TYPE_EFFECT | TYPE_PENDULUM, 0x01040005, 5, -1, -1, 1, 4},
+		{"pendulum scale 0 and 13", TYPE_MONSTER | TYPE_EFFECT | TYPE_PENDULUM, 0x000d0003, 3, -1, -1, 0, 13},
+		{"pendulum xyz", TYPE_MONSTER | TYPE_EFFECT | TYPE_XYZ | TYPE_PENDULUM, 0x05050004, -1, 4, -1, 5, 5},
+		{"pendulum xyz unequal scales", TYPE_MONSTER | TYPE_EFFECT | TYPE_XYZ | TYPE_PENDULUM, 0x02060007, -1, 7, -1, 2, 6},
+		{"spell", TYPE_SPELL | TYPE_QUICKPLAY, 0, -1, -1, -1, -1, -1},
+		{"pendulum spell", TYPE_SPELL | TYPE_PENDULUM, 0x03030000, -1, -1, -1, 3, 3},
+	}
+	for _, test := range tests {
+		card := Card{Type: int64(test.cardType), originLevel: test.originLevel}
+		if got := card.Level(); got != test.level {
+			t.Errorf("%v: Level() = %v, want %v", test.name, got, test.level)
+		}
+		if got := card.Rank(); got != test.rank {
+			t.Errorf("%v: Rank() = %v, want %v", test.name, got, test.rank)
+		}
+		if got := card.LinkRating(); got != test.link {
+			t.Errorf("%v: LinkRating() = %v, want %v", test.name, got, test.link)
+		}
+		if got := card.LeftScale(); got != test.left {
+			t.Errorf("%v: LeftScale() = %v, want %v", test.name, got, test.left)
+		}
+		if got := card.RightScale(); got != test.right {
+			t.Errorf("%v: RightScale() = %v, want %v", test.name, got, test.right)
+		}
+	}
+}
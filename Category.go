@@ -0,0 +1,153 @@
+package ygopro_data
+
+import (
+	"math/bits"
+	"strings"
+)
+
+// strings.conf 中效果分类名称的起始编号，第 n 位对应 !system 1100+n
+const CATEGORY_SYSTEM_STRING_BASE = 1100
+
+// 卡片数据库 category 列的效果分类，即卡组编辑器中的检索分类
+// 与脚本中 Constant.lua 的 CATEGORY_（Category 类型）不是同一套位
+type CardCategory int64
+
+const (
+	CARD_CATEGORY_DESTROY_SPELL_TRAP CardCategory = 1 << iota // 魔陷破坏
+	CARD_CATEGORY_DESTROY_MONSTER                             // 怪兽破坏
+	CARD_CATEGORY_BANISH                                      // 卡片除外
+	CARD_CATEGORY_SEND_TO_GRAVE                               // 送去墓地
+	CARD_CATEGORY_RETURN_TO_HAND                              // 返回手卡
+	CARD_CATEGORY_RETURN_TO_DECK                              // 返回卡组
+	CARD_CATEGORY_DESTROY_HAND                                // 手卡破坏
+	CARD_CATEGORY_DESTROY_DECK                                // 卡组破坏
+	CARD_CATEGORY_DRAW                                        // 抽卡辅助
+	CARD_CATEGORY_SEARCH                                      // 卡组检索
+	CARD_CATEGORY_RECYCLE                                     // 卡片回收
+	CARD_CATEGORY_POSITION                                    // 表示形式
+	CARD_CATEGORY_CONTROL                                     // 控制权
+	CARD_CATEGORY_ATK_DEF                                     // 攻守变化
+	CARD_CATEGORY_PIERCE                                      // 贯穿伤害
+	CARD_CATEGORY_MULTI_ATTACK                                // 多次攻击
+	CARD_CATEGORY_ATTACK_LIMIT                                // 攻击限制
+	CARD_CATEGORY_DIRECT_ATTACK                               // 直接攻击
+	CARD_CATEGORY_SPECIAL_SUMMON                              // 特殊召唤
+	CARD_CATEGORY_TOKEN                                       // 衍生物
+	CARD_CATEGORY_RACE                                        // 种族相关
+	CARD_CATEGORY_ATTRIBUTE                                   // 属性相关
+	CARD_CATEGORY_DAMAGE                                      // LP 伤害
+	CARD_CATEGORY_RECOVER                                     // LP 回复
+	CARD_CATEGORY_DESTROY_IMMUNE                              // 破坏耐性
+	CARD_CATEGORY_EFFECT_IMMUNE                               // 效果耐性
+	CARD_CATEGORY_COUNTER                                     // 指示物
+	CARD_CATEGORY_GAMBLE                                      // 幸运
+	CARD_CATEGORY_FUSION                                      // 融合相关
+	CARD_CATEGORY_SYNCHRO                                     // 同调相关
+	CARD_CATEGORY_XYZ                                         // 超量相关
+	CARD_CATEGORY_NEGATE                                      // 效果无效
+)
+
+var cardCategoryNames = []constantName{
+	{int64(CARD_CATEGORY_DESTROY_SPELL_TRAP), "CARD_CATEGORY_DESTROY_SPELL_TRAP"},
+	{int64(CARD_CATEGORY_DESTROY_MONSTER), "CARD_CATEGORY_DESTROY_MONSTER"},
+	{int64(CARD_CATEGORY_BANISH), "CARD_CATEGORY_BANISH"},
+	{int64(CARD_CATEGORY_SEND_TO_GRAVE), "CARD_CATEGORY_SEND_TO_GRAVE"},
+	{int64(CARD_CATEGORY_RETURN_TO_HAND), "CARD_CATEGORY_RETURN_TO_HAND"},
+	{int64(CARD_CATEGORY_RETURN_TO_DECK), "CARD_CATEGORY_RETURN_TO_DECK"},
+	{int64(CARD_CATEGORY_DESTROY_HAND), "CARD_CATEGORY_DESTROY_HAND"},
+	{int64(CARD_CATEGORY_DESTROY_DECK), "CARD_CATEGORY_DESTROY_DECK"},
+	{int64(CARD_CATEGORY_DRAW), "CARD_CATEGORY_DRAW"},
+	{int64(CARD_CATEGORY_SEARCH), "CARD_CATEGORY_SEARCH"},
+	{int64(CARD_CATEGORY_RECYCLE), "CARD_CATEGORY_RECYCLE"},
+	{int64(CARD_CATEGORY_POSITION), "CARD_CATEGORY_POSITION"},
+	{int64(CARD_CATEGORY_CONTROL), "CARD_CATEGORY_CONTROL"},
+	{int64(CARD_CATEGORY_ATK_DEF), "CARD_CATEGORY_ATK_DEF"},
+	{int64(CARD_CATEGORY_PIERCE), "CARD_CATEGORY_PIERCE"},
+	{int64(CARD_CATEGORY_MULTI_ATTACK), "CARD_CATEGORY_MULTI_ATTACK"},
+	{int64(CARD_CATEGORY_ATTACK_LIMIT), "CARD_CATEGORY_ATTACK_LIMIT"},
+	{int64(CARD_CATEGORY_DIRECT_ATTACK), "CARD_CATEGORY_DIRECT_ATTACK"},
+	{int64(CARD_CATEGORY_SPECIAL_SUMMON), "CARD_CATEGORY_SPECIAL_SUMMON"},
+	{int64(CARD_CATEGORY_TOKEN), "CARD_CATEGORY_TOKEN"},
+	{int64(CARD_CATEGORY_RACE), "CARD_CATEGORY_RACE"},
+	{int64(CARD_CATEGORY_ATTRIBUTE), "CARD_CATEGORY_ATTRIBUTE"},
+	{int64(CARD_CATEGORY_DAMAGE), "CARD_CATEGORY_DAMAGE"},
+	{int64(CARD_CATEGORY_RECOVER), "CARD_CATEGORY_RECOVER"},
+	{int64(CARD_CATEGORY_DESTROY_IMMUNE), "CARD_CATEGORY_DESTROY_IMMUNE"},
+	{int64(CARD_CATEGORY_EFFECT_IMMUNE), "CARD_CATEGORY_EFFECT_IMMUNE"},
+	{int64(CARD_CATEGORY_COUNTER), "CARD_CATEGORY_COUNTER"},
+	{int64(CARD_CATEGORY_GAMBLE), "CARD_CATEGORY_GAMBLE"},
+	{int64(CARD_CATEGORY_FUSION), "CARD_CATEGORY_FUSION"},
+	{int64(CARD_CATEGORY_SYNCHRO), "CARD_CATEGORY_SYNCHRO"},
+	{int64(CARD_CATEGORY_XYZ), "CARD_CATEGORY_XYZ"},
+	{int64(CARD_CATEGORY_NEGATE), "CARD_CATEGORY_NEGATE"},
+}
+
+func (value CardCategory) String() string {
+	return constantString(int64(value), cardCategoryNames, "CardCategory")
+}
+
+func (card *Card) Categories() []CardCategory {
+	var categories []CardCategory
+	for _, constant := range cardCategoryNames {
+		if category := CardCategory(constant.value); card.HasCategory(category) {
+			categories = append(categories, category)
+		}
+	}
+	return categories
+}
+
+// 卡片是否同时具有所有给定的效果分类
+func (card *Card) HasCategory(categories ...CardCategory) bool {
+	for _, category := range categories {
+		if card.Category&category != category {
+			return false
+		}
+	}
+	return true
+}
+
+func (card *Card) CategoryNames() []string {
+	environment := GetEnvironment(card.Locale)
+	var names []string
+	for _, category := range card.Categories() {
+		if text, exist := environment.CategoryText(category); exist {
+			names = append(names, text)
+		}
+	}
+	return names
+}
+
+func (environment *Environment) CategoryText(category CardCategory) (string, bool) {
+	return environment.SystemString(categorySystemNumber(category))
+}
+
+func categorySystemNumber(category CardCategory) int64 {
+	return CATEGORY_SYSTEM_STRING_BASE + int64(bits.TrailingZeros64(uint64(category)))
+}
+
+func (environment *Environment) linkCategoryNames() {
+	constants := make([]property, 0, len(cardCategoryNames))
+	for _, constant := range cardCategoryNames {
+		constants = checkAndAddConstant(constant.name, constant.value, "CARD_CATEGORY_", constants)
+	}
+	environment.linkStringsAndConstantsPattern("CARD_CATEGORY_", CATEGORY_SYSTEM_STRING_BASE, CATEGORY_SYSTEM_STRING_BASE+32, constants, &environment.Categories)
+}
+
+// 根据效果分类获取卡片，返回同时具有所有分类的卡片
+func (environment *Environment) GetAllCategoryCard(categories ...CardCategory) Set {
+	var mask CardCategory
+	var names []string
+	for _, category := range categories {
+		mask |= category
+		if text, exist := environment.CategoryText(category); exist {
+			names = append(names, text)
+		} else {
+			names = append(names, category.String())
+		}
+	}
+	if mask == 0 {
+		return Set{}
+	}
+	ids := environment.queryIds(QUERY_CATEGORY_SQL, int64(mask), int64(mask))
+	return createIdSet(environment.Locale, strings.Join(names, "+"), ids)
+}
@@ -0,0 +1,198 @@
+package ygopro_data
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// ygopro 标准的 cdb 结构
+const CREATE_CDB_SQL = `create table if not exists datas(id integer primary key,ot integer,alias integer,setcode integer,type integer,atk integer,def integer,level integer,race integer,attribute integer,category integer);
+create table if not exists texts(id integer primary key,name text,desc text,str1 text,str2 text,str3 text,str4 text,str5 text,str6 text,str7 text,str8 text,str9 text,str10 text,str11 text,str12 text,str13 text,str14 text,str15 text,str16 text);`
+
+const DELETE_DATA_SQL = "delete from datas where id=?"
+const DELETE_TEXT_SQL = "delete from texts where id=?"
+
+// 写入 cdb 文件，文件不存在时按标准结构创建
+// 每次写入前检查卡片的位字段，写入在同一事务中更新 datas 与 texts。
+// 只写入文件中存在的标准列，额外的列保持默认值；缺少的列上有非零值时返回错误。
+type CdbWriter struct {
+	Path string
+
+	db    *sql.DB
+	datas map[string]bool
+	texts map[string]bool
+}
+
+func NewCdbWriter(path string) (*CdbWriter, error) {
+	db, err := sql.Open("sqlite3", path)
+	if err != nil {
+		return nil, err
+	}
+	writer := &CdbWriter{Path: path, db: db}
+	if _, err = db.Exec(CREATE_CDB_SQL); err == nil {
+		if writer.datas, err = tableColumns(db, "datas"); err == nil {
+			writer.texts, err = tableColumns(db, "texts")
+		}
+	}
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	return writer, nil
+}
+
+func (writer *CdbWriter) Close() error {
+	return writer.db.Close()
+}
+
+func (writer *CdbWriter) Insert(card Card) error {
+	return writer.write(card, func(tx *sql.Tx, table string, columns []string, values []interface{}) error {
+		placeholders := strings.Repeat(",?", len(columns))
+		query := fmt.Sprintf("insert into %v (id,%v) values (?%v)", table, strings.Join(columns, ","), placeholders)
+		_, err := tx.Exec(query, append([]interface{}{card.Id}, values...)...)
+		return err
+	})
+}
+
+// 更新已有的卡片，卡片不存在时返回错误
+func (writer *CdbWriter) Update(card Card) error {
+	return writer.write(card, func(tx *sql.Tx, table string, columns []string, values []interface{}) error {
+		query := fmt.Sprintf("update %v set %v=? where id=?", table, strings.Join(columns, "=?,"))
+		result, err := tx.Exec(query, append(values, card.Id)...)
+		if err != nil {
+			return err
+		}
+		if affected, err := result.RowsAffected(); err != nil {
+			return err
+		} else if affected == 0 {
+			return fmt.Errorf("card %v not found in %v", card.Id, writer.Path)
+		}
+		return nil
+	})
+}
+
+func (writer *CdbWriter) Delete(id int) error {
+	return writer.transaction(func(tx *sql.Tx) error {
+		if _, err := tx.Exec(DELETE_DATA_SQL, id); err != nil {
+			return err
+		}
+		_, err := tx.Exec(DELETE_TEXT_SQL, id)
+		return err
+	})
+}
+
+type writeTable func(tx *sql.Tx, table string, columns []string, values []interface{}) error
+
+func (writer *CdbWriter) write(card Card, action writeTable) error {
+	if err := ValidateCard(&card); err != nil {
+		return err
+	}
+	dataColumns, dataValues, err := presentColumns(&card, datasColumns, cardData(&card), writer.datas)
+	if err != nil {
+		return err
+	}
+	textColumns, textValues, err := presentColumns(&card, textsColumns, cardTexts(&card), writer.texts)
+	if err != nil {
+		return err
+	}
+	return writer.transaction(func(tx *sql.Tx) error {
+		if err := action(tx, "datas", dataColumns, dataValues); err != nil {
+			return err
+		}
+		return action(tx, "texts", textColumns, textValues)
+	})
+}
+
+func (writer *CdbWriter) transaction(action func(tx *sql.Tx) error) error {
+	tx, err := writer.db.Begin()
+	if err != nil {
+		return err
+	}
+	if err := action(tx); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// 与 datasColumns 的顺序相同
+func cardData(card *Card) []interface{} {
+	return []interface{}{card.Ot, card.Alias, card.Setcode, card.Type, card.Atk, card.Def, card.originLevel, card.Race, card.Attribute, card.Category}
+}
+
+// 与 textsColumns 的顺序相同
+func cardTexts(card *Card) []interface{} {
+	texts := []interface{}{card.Name, card.Desc}
+	for _, str := range card.Strings {
+		texts = append(texts, str)
+	}
+	return texts
+}
+
+func presentColumns(card *Card, columns []string, values []interface{}, present map[string]bool) ([]string, []interface{}, error) {
+	var answerColumns []string
+	var answerValues []interface{}
+	for i, column := range columns {
+		if present[column] {
+			answerColumns = append(answerColumns, column)
+			answerValues = append(answerValues, values[i])
+		} else if !reflect.ValueOf(values[i]).IsZero() {
+			return nil, nil, fmt.Errorf("card %v: column %v does not exist", card.Id, column)
+		}
+	}
+	return answerColumns, answerValues, nil
+}
+
+// 检查卡片的位字段是否都是 Constant.lua 中定义的值
+func ValidateCard(card *Card) error {
+	if problems := cardProblems(card); len(problems) > 0 {
+		return fmt.Errorf("card %v: %v", card.Id, strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+func cardProblems(card *Card) []string {
+	var problems []string
+	cardType := card.CardType()
+	if card.Id <= 0 {
+		problems = append(problems, "id must be positive")
+	}
+	if rest := int64(cardType) &^ singleBits(cardTypeNames); rest != 0 {
+		problems = append(problems, fmt.Sprintf("unknown type bits 0x%x", rest))
+	}
+	if category := cardType.Category(); category&(category-1) != 0 || category == 0 {
+		problems = append(problems, "type must be exactly one of monster, spell and trap")
+	}
+	if rest := int64(card.Race) &^ singleBits(raceNames); rest != 0 {
+		problems = append(problems, fmt.Sprintf("unknown race bits 0x%x", rest))
+	}
+	if rest := int64(card.Attribute) &^ singleBits(attributeNames); rest != 0 {
+		problems = append(problems, fmt.Sprintf("unknown attribute bits 0x%x", rest))
+	} else if card.Attribute&(card.Attribute-1) != 0 {
+		problems = append(problems, fmt.Sprintf("multiple attributes 0x%x", card.Attribute))
+	}
+	if cardType.IsLink() {
+		if LinkMarker(card.Def)&^LINK_MARKER_ALL != 0 {
+			problems = append(problems, fmt.Sprintf("invalid link markers 0x%x", card.Def))
+		} else if count := card.LinkMarker().Count(); count != card.LinkRating() {
+			problems = append(problems, fmt.Sprintf("%v link markers for link rating %v", count, card.LinkRating()))
+		}
+	}
+	if !cardType.IsPendulum() && card.originLevel&^0xff != 0 {
+		problems = append(problems, "pendulum scales on a non-pendulum card")
+	}
+	return problems
+}
+
+// 常量表中所有单个位的并集
+func singleBits(names []constantName) int64 {
+	var answer int64
+	for _, constant := range names {
+		if constant.value > 0 && constant.value&(constant.value-1) == 0 {
+			answer |= constant.value
+		}
+	}
+	return answer
+}
@@ -0,0 +1,30 @@
+package ygopro_data
+
+import "testing"
+
+func TestPresentColumns(t *testing.T) {
+	present := map[string]bool{"id": true, "ot": true}
+	card := Card{Id: 1}
+	tests := []struct {
+		name     string
+		values   []interface{}
+		expected int
+		fails    bool
+	}{
+		{"missing columns are zero", []interface{}{1, 3, int64(0), CardCategory(0), ""}, 2, false},
+		{"missing int64 column set", []interface{}{1, 3, int64(0x34), CardCategory(0), ""}, 0, true},
+		{"missing category column set", []interface{}{1, 3, int64(0), CARD_CATEGORY_DESTROY_SPELL_TRAP, ""}, 0, true},
+		{"missing text column set", []interface{}{1, 3, int64(0), CardCategory(0), "0"}, 0, true},
+	}
+	columns := []string{"id", "ot", "setcode", "category", "str1"}
+	for _, test := range tests {
+		answerColumns, answerValues, err := presentColumns(&card, columns, test.values, present)
+		if (err != nil) != test.fails {
+			t.Errorf("%v: error = %v", test.name, err)
+			continue
+		}
+		if len(answerColumns) != test.expected || len(answerValues) != test.expected {
+			t.Errorf("%v: got %v columns, expected %v", test.name, len(answerColumns), test.expected)
+		}
+	}
+}
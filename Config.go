@@ -0,0 +1,123 @@
+package ygopro_data
+
+import (
+	"io/fs"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+)
+
+// 环境变量，优先于 GOPATH 推导出的默认路径
+const DATABASE_PATH_ENV = "YGOPRO_DATABASE_PATH"
+const LUA_PATH_ENV = "YGOPRO_LUA_PATH"
+const SCRIPT_PATH_ENV = "YGOPRO_SCRIPT_PATH"
+
+var DatabasePath = defaultPath(DATABASE_PATH_ENV, "src/github.com/iamipanda/ygopro-data/ygopro-database/locales/")
+var LuaPath = defaultPath(LUA_PATH_ENV, "src/github.com/iamipanda/ygopro-data/Constant.lua")
+
+// 卡片脚本（c<id>.lua）所在的目录，脚本不随本仓库分发，没有默认值
+var ScriptPath = os.Getenv(SCRIPT_PATH_ENV)
+
+func defaultPath(env string, gopathRelative string) string {
+	if value := os.Getenv(env); len(value) > 0 {
+		return value
+	}
+	return filepath.Join(os.Getenv("GOPATH"), gopathRelative)
+}
+
+// 构造选项
+type EnvironmentOptions struct {
+	// 语言目录所在的文件夹，其下为 <locale>/strings.conf 与 <locale>/*.cdb；为空时使用 DatabasePath
+	DatabasePath string
+	// Constant.lua 的路径；为空时使用 LuaPath
+	LuaPath string
+	// 卡片脚本目录；为空时使用 ScriptPath
+	ScriptPath string
+	// 卡图目录（pics/<id>.jpg）、场地卡图目录（pics/field/<id>.png）与缩略图目录，为空时不查找
+	PicPath       string
+	FieldPicPath  string
+	ThumbnailPath string
+	// Initialize 时创建的语言环境
+	Locales []string
+	// 额外读取的 cdb 文件，排在语言目录中的 cdb 之后
+	ExtraCdbs []string
+	// 不为空时从该文件系统读取数据（例如 embed.FS），上面的路径都是其中以 / 分隔的路径
+	FS fs.FS
+
+	// 延迟到第一次查询系列成员时才扫描卡片数据库（见 LinkSets）
+	LazySets bool
+	// 构造时读取全部卡片，默认在 GetCard 时按需读取
+	PreloadCards bool
+}
+
+var DefaultEnvironmentOptions = EnvironmentOptions{}
+
+// 读取 Constant.lua，并按 Locales 创建语言环境；之后 GetEnvironment 使用这些选项
+func Initialize(options EnvironmentOptions) error {
+	bytes, err := options.readFile(options.luaPath())
+	if err != nil {
+		return err
+	}
+	loadLuaLines(string(bytes))
+	DefaultEnvironmentOptions = options
+	for _, locale := range options.Locales {
+		GetEnvironmentWithOptions(locale, options)
+	}
+	return nil
+}
+
+func (options EnvironmentOptions) databasePath() string {
+	if len(options.DatabasePath) > 0 {
+		return options.DatabasePath
+	}
+	if options.FS != nil {
+		return "."
+	}
+	return DatabasePath
+}
+
+func (options EnvironmentOptions) luaPath() string {
+	if len(options.LuaPath) > 0 {
+		return options.LuaPath
+	}
+	if options.FS != nil {
+		return "Constant.lua"
+	}
+	return LuaPath
+}
+
+func (options EnvironmentOptions) scriptPath() string {
+	if len(options.ScriptPath) > 0 {
+		return options.ScriptPath
+	}
+	return ScriptPath
+}
+
+func (options EnvironmentOptions) join(elements ...string) string {
+	if options.FS != nil {
+		return path.Join(elements...)
+	}
+	return filepath.Join(elements...)
+}
+
+func (options EnvironmentOptions) readFile(filePath string) ([]byte, error) {
+	if options.FS != nil {
+		return fs.ReadFile(options.FS, filePath)
+	}
+	return ioutil.ReadFile(filePath)
+}
+
+func (options EnvironmentOptions) glob(pattern string) ([]string, error) {
+	if options.FS != nil {
+		return fs.Glob(options.FS, pattern)
+	}
+	return filepath.Glob(pattern)
+}
+
+func (options EnvironmentOptions) stat(filePath string) (os.FileInfo, error) {
+	if options.FS != nil {
+		return fs.Stat(options.FS, filePath)
+	}
+	return os.Stat(filePath)
+}
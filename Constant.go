@@ -0,0 +1,3344 @@
+// Code generated by luaconst from Constant.lua; DO NOT EDIT.
+
+package ygopro_data
+
+import (
+	"fmt"
+	"strings"
+)
+
+type constantName struct {
+	value int64
+	name  string
+}
+
+func constantString(value int64, names []constantName, typeName string) string {
+	for _, constant := range names {
+		if constant.value == value {
+			return constant.name
+		}
+	}
+	rest := value
+	var parts []string
+	for _, constant := range names {
+		if constant.value > 0 && constant.value&(constant.value-1) == 0 && rest&constant.value > 0 {
+			parts = append(parts, constant.name)
+			rest &^= constant.value
+		}
+	}
+	if len(parts) == 0 || rest != 0 {
+		return fmt.Sprintf("%v(0x%x)", typeName, value)
+	}
+	return strings.Join(parts, "|")
+}
+
+// Location 区域
+type Location int64
+
+const (
+	// 卡组
+	LOCATION_DECK Location = 0x01
+	// 手牌
+	LOCATION_HAND Location = 0x02
+	// 怪兽区
+	LOCATION_MZONE Location = 0x04
+	// 魔陷区
+	LOCATION_SZONE Location = 0x08
+	// 墓地
+	LOCATION_GRAVE Location = 0x10
+	// 除外区
+	LOCATION_REMOVED Location = 0x20
+	// 额外
+	LOCATION_EXTRA Location = 0x40
+	// 超量素材
+	LOCATION_OVERLAY Location = 0x80
+	// 场上（怪兽+魔陷）
+	LOCATION_ONFIELD Location = 0x0c
+	// 弹回卡组底部
+	LOCATION_DECKBOT Location = 0x10001
+	// 弹回卡组并洗牌
+	LOCATION_DECKSHF Location = 0x20001
+	// 场地区
+	LOCATION_FZONE Location = 0x100
+	// 灵摆区
+	LOCATION_PZONE Location = 0x200
+)
+
+var locationValues = map[string]Location{
+	"deck":    LOCATION_DECK,
+	"hand":    LOCATION_HAND,
+	"mzone":   LOCATION_MZONE,
+	"szone":   LOCATION_SZONE,
+	"grave":   LOCATION_GRAVE,
+	"removed": LOCATION_REMOVED,
+	"extra":   LOCATION_EXTRA,
+	"overlay": LOCATION_OVERLAY,
+	"onfield": LOCATION_ONFIELD,
+	"deckbot": LOCATION_DECKBOT,
+	"deckshf": LOCATION_DECKSHF,
+	"fzone":   LOCATION_FZONE,
+	"pzone":   LOCATION_PZONE,
+}
+
+var locationNames = []constantName{
+	{0x01, "LOCATION_DECK"},
+	{0x02, "LOCATION_HAND"},
+	{0x04, "LOCATION_MZONE"},
+	{0x08, "LOCATION_SZONE"},
+	{0x10, "LOCATION_GRAVE"},
+	{0x20, "LOCATION_REMOVED"},
+	{0x40, "LOCATION_EXTRA"},
+	{0x80, "LOCATION_OVERLAY"},
+	{0x0c, "LOCATION_ONFIELD"},
+	{0x10001, "LOCATION_DECKBOT"},
+	{0x20001, "LOCATION_DECKSHF"},
+	{0x100, "LOCATION_FZONE"},
+	{0x200, "LOCATION_PZONE"},
+}
+
+func (value Location) String() string {
+	return constantString(int64(value), locationNames, "Location")
+}
+
+// LocationReason 区域计数的原因
+type LocationReason int64
+
+const (
+	// Duel.GetLocationCount()預設值,凱薩競技場
+	LOCATION_REASON_TOFIELD LocationReason = 0x1
+	// Card.IsControlerCanBeChanged()使用
+	LOCATION_REASON_CONTROL LocationReason = 0x2
+)
+
+var locationReasonValues = map[string]LocationReason{
+	"tofield": LOCATION_REASON_TOFIELD,
+	"control": LOCATION_REASON_CONTROL,
+}
+
+var locationReasonNames = []constantName{
+	{0x1, "LOCATION_REASON_TOFIELD"},
+	{0x2, "LOCATION_REASON_CONTROL"},
+}
+
+func (value LocationReason) String() string {
+	return constantString(int64(value), locationReasonNames, "LocationReason")
+}
+
+// Position 表示形式
+type Position int64
+
+const (
+	// 表侧攻击
+	POS_FACEUP_ATTACK Position = 0x1
+	// 里侧攻击
+	POS_FACEDOWN_ATTACK Position = 0x2
+	// 表侧守备
+	POS_FACEUP_DEFENSE Position = 0x4
+	// 里侧守备
+	POS_FACEDOWN_DEFENSE Position = 0x8
+	// 正面表示
+	POS_FACEUP Position = 0x5
+	// 背面表示
+	POS_FACEDOWN Position = 0xa
+	// 攻击表示
+	POS_ATTACK Position = 0x3
+	// 守备表示
+	POS_DEFENSE Position = 0xc
+)
+
+var positionValues = map[string]Position{
+	"faceup_attack":    POS_FACEUP_ATTACK,
+	"facedown_attack":  POS_FACEDOWN_ATTACK,
+	"faceup_defense":   POS_FACEUP_DEFENSE,
+	"facedown_defense": POS_FACEDOWN_DEFENSE,
+	"faceup":           POS_FACEUP,
+	"facedown":         POS_FACEDOWN,
+	"attack":           POS_ATTACK,
+	"defense":          POS_DEFENSE,
+}
+
+var positionNames = []constantName{
+	{0x1, "POS_FACEUP_ATTACK"},
+	{0x2, "POS_FACEDOWN_ATTACK"},
+	{0x4, "POS_FACEUP_DEFENSE"},
+	{0x8, "POS_FACEDOWN_DEFENSE"},
+	{0x5, "POS_FACEUP"},
+	{0xa, "POS_FACEDOWN"},
+	{0x3, "POS_ATTACK"},
+	{0xc, "POS_DEFENSE"},
+}
+
+func (value Position) String() string {
+	return constantString(int64(value), positionNames, "Position")
+}
+
+// CardType 卡片类型
+type CardType int64
+
+const (
+	// 怪兽卡
+	TYPE_MONSTER CardType = 0x1
+	// 魔法卡
+	TYPE_SPELL CardType = 0x2
+	// 陷阱卡
+	TYPE_TRAP CardType = 0x4
+	// 通常怪兽
+	TYPE_NORMAL CardType = 0x10
+	// 效果
+	TYPE_EFFECT CardType = 0x20
+	// 融合
+	TYPE_FUSION CardType = 0x40
+	// 仪式
+	TYPE_RITUAL CardType = 0x80
+	// 陷阱怪兽
+	TYPE_TRAPMONSTER CardType = 0x100
+	// 灵魂
+	TYPE_SPIRIT CardType = 0x200
+	// 同盟
+	TYPE_UNION CardType = 0x400
+	// 二重
+	TYPE_DUAL CardType = 0x800
+	// 调整
+	TYPE_TUNER CardType = 0x1000
+	// 同调
+	TYPE_SYNCHRO CardType = 0x2000
+	// 衍生物
+	TYPE_TOKEN CardType = 0x4000
+	// 速攻
+	TYPE_QUICKPLAY CardType = 0x10000
+	// 永续
+	TYPE_CONTINUOUS CardType = 0x20000
+	// 装备
+	TYPE_EQUIP CardType = 0x40000
+	// 场地
+	TYPE_FIELD CardType = 0x80000
+	// 反击
+	TYPE_COUNTER CardType = 0x100000
+	// 翻转
+	TYPE_FLIP CardType = 0x200000
+	// 卡通
+	TYPE_TOON CardType = 0x400000
+	// 超量
+	TYPE_XYZ CardType = 0x800000
+	// 灵摆
+	TYPE_PENDULUM CardType = 0x1000000
+	// 特殊召唤
+	TYPE_SPSUMMON CardType = 0x2000000
+	// 连接
+	TYPE_LINK CardType = 0x4000000
+)
+
+var cardTypeValues = map[string]CardType{
+	"monster":     TYPE_MONSTER,
+	"spell":       TYPE_SPELL,
+	"trap":        TYPE_TRAP,
+	"normal":      TYPE_NORMAL,
+	"effect":      TYPE_EFFECT,
+	"fusion":      TYPE_FUSION,
+	"ritual":      TYPE_RITUAL,
+	"trapmonster": TYPE_TRAPMONSTER,
+	"spirit":      TYPE_SPIRIT,
+	"union":       TYPE_UNION,
+	"dual":        TYPE_DUAL,
+	"tuner":       TYPE_TUNER,
+	"synchro":     TYPE_SYNCHRO,
+	"token":       TYPE_TOKEN,
+	"quickplay":   TYPE_QUICKPLAY,
+	"continuous":  TYPE_CONTINUOUS,
+	"equip":       TYPE_EQUIP,
+	"field":       TYPE_FIELD,
+	"counter":     TYPE_COUNTER,
+	"flip":        TYPE_FLIP,
+	"toon":        TYPE_TOON,
+	"xyz":         TYPE_XYZ,
+	"pendulum":    TYPE_PENDULUM,
+	"spsummon":    TYPE_SPSUMMON,
+	"link":        TYPE_LINK,
+}
+
+var cardTypeNames = []constantName{
+	{0x1, "TYPE_MONSTER"},
+	{0x2, "TYPE_SPELL"},
+	{0x4, "TYPE_TRAP"},
+	{0x10, "TYPE_NORMAL"},
+	{0x20, "TYPE_EFFECT"},
+	{0x40, "TYPE_FUSION"},
+	{0x80, "TYPE_RITUAL"},
+	{0x100, "TYPE_TRAPMONSTER"},
+	{0x200, "TYPE_SPIRIT"},
+	{0x400, "TYPE_UNION"},
+	{0x800, "TYPE_DUAL"},
+	{0x1000, "TYPE_TUNER"},
+	{0x2000, "TYPE_SYNCHRO"},
+	{0x4000, "TYPE_TOKEN"},
+	{0x10000, "TYPE_QUICKPLAY"},
+	{0x20000, "TYPE_CONTINUOUS"},
+	{0x40000, "TYPE_EQUIP"},
+	{0x80000, "TYPE_FIELD"},
+	{0x100000, "TYPE_COUNTER"},
+	{0x200000, "TYPE_FLIP"},
+	{0x400000, "TYPE_TOON"},
+	{0x800000, "TYPE_XYZ"},
+	{0x1000000, "TYPE_PENDULUM"},
+	{0x2000000, "TYPE_SPSUMMON"},
+	{0x4000000, "TYPE_LINK"},
+}
+
+func (value CardType) String() string {
+	return constantString(int64(value), cardTypeNames, "CardType")
+}
+
+// Attribute 属性
+type Attribute int64
+
+const (
+	// 地
+	ATTRIBUTE_EARTH Attribute = 0x01
+	// 水
+	ATTRIBUTE_WATER Attribute = 0x02
+	// 炎
+	ATTRIBUTE_FIRE Attribute = 0x04
+	// 风
+	ATTRIBUTE_WIND Attribute = 0x08
+	// 光
+	ATTRIBUTE_LIGHT Attribute = 0x10
+	// 暗
+	ATTRIBUTE_DARK Attribute = 0x20
+	// 神
+	ATTRIBUTE_DEVINE Attribute = 0x40
+)
+
+var attributeValues = map[string]Attribute{
+	"earth":  ATTRIBUTE_EARTH,
+	"water":  ATTRIBUTE_WATER,
+	"fire":   ATTRIBUTE_FIRE,
+	"wind":   ATTRIBUTE_WIND,
+	"light":  ATTRIBUTE_LIGHT,
+	"dark":   ATTRIBUTE_DARK,
+	"devine": ATTRIBUTE_DEVINE,
+}
+
+var attributeNames = []constantName{
+	{0x01, "ATTRIBUTE_EARTH"},
+	{0x02, "ATTRIBUTE_WATER"},
+	{0x04, "ATTRIBUTE_FIRE"},
+	{0x08, "ATTRIBUTE_WIND"},
+	{0x10, "ATTRIBUTE_LIGHT"},
+	{0x20, "ATTRIBUTE_DARK"},
+	{0x40, "ATTRIBUTE_DEVINE"},
+}
+
+func (value Attribute) String() string {
+	return constantString(int64(value), attributeNames, "Attribute")
+}
+
+// Race 种族
+type Race int64
+
+const (
+	// 全种族
+	RACE_ALL Race = 0xffffff
+	// 战士
+	RACE_WARRIOR Race = 0x1
+	// 魔法师
+	RACE_SPELLCASTER Race = 0x2
+	// 天使
+	RACE_FAIRY Race = 0x4
+	// 恶魔
+	RACE_FIEND Race = 0x8
+	// 不死
+	RACE_ZOMBIE Race = 0x10
+	// 机械
+	RACE_MACHINE Race = 0x20
+	// 水
+	RACE_AQUA Race = 0x40
+	// 炎
+	RACE_PYRO Race = 0x80
+	// 岩石
+	RACE_ROCK Race = 0x100
+	// 鸟兽
+	RACE_WINDBEAST Race = 0x200
+	// 植物
+	RACE_PLANT Race = 0x400
+	// 昆虫
+	RACE_INSECT Race = 0x800
+	// 雷
+	RACE_THUNDER Race = 0x1000
+	// 龙
+	RACE_DRAGON Race = 0x2000
+	// 兽
+	RACE_BEAST Race = 0x4000
+	// 兽战士
+	RACE_BEASTWARRIOR Race = 0x8000
+	// 恐龙
+	RACE_DINOSAUR Race = 0x10000
+	// 鱼
+	RACE_FISH Race = 0x20000
+	// 海龙
+	RACE_SEASERPENT Race = 0x40000
+	// 爬虫
+	RACE_REPTILE Race = 0x80000
+	// 念动力
+	RACE_PSYCHO Race = 0x100000
+	// 幻神兽
+	RACE_DEVINE Race = 0x200000
+	// 创造神
+	RACE_CREATORGOD Race = 0x400000
+	// 幻龙
+	RACE_WYRM Race = 0x800000
+	// 电子世界
+	RACE_CYBERS Race = 0x1000000
+)
+
+var raceValues = map[string]Race{
+	"all":          RACE_ALL,
+	"warrior":      RACE_WARRIOR,
+	"spellcaster":  RACE_SPELLCASTER,
+	"fairy":        RACE_FAIRY,
+	"fiend":        RACE_FIEND,
+	"zombie":       RACE_ZOMBIE,
+	"machine":      RACE_MACHINE,
+	"aqua":         RACE_AQUA,
+	"pyro":         RACE_PYRO,
+	"rock":         RACE_ROCK,
+	"windbeast":    RACE_WINDBEAST,
+	"plant":        RACE_PLANT,
+	"insect":       RACE_INSECT,
+	"thunder":      RACE_THUNDER,
+	"dragon":       RACE_DRAGON,
+	"beast":        RACE_BEAST,
+	"beastwarrior": RACE_BEASTWARRIOR,
+	"dinosaur":     RACE_DINOSAUR,
+	"fish":         RACE_FISH,
+	"seaserpent":   RACE_SEASERPENT,
+	"reptile":      RACE_REPTILE,
+	"psycho":       RACE_PSYCHO,
+	"devine":       RACE_DEVINE,
+	"creatorgod":   RACE_CREATORGOD,
+	"wyrm":         RACE_WYRM,
+	"cybers":       RACE_CYBERS,
+}
+
+var raceNames = []constantName{
+	{0xffffff, "RACE_ALL"},
+	{0x1, "RACE_WARRIOR"},
+	{0x2, "RACE_SPELLCASTER"},
+	{0x4, "RACE_FAIRY"},
+	{0x8, "RACE_FIEND"},
+	{0x10, "RACE_ZOMBIE"},
+	{0x20, "RACE_MACHINE"},
+	{0x40, "RACE_AQUA"},
+	{0x80, "RACE_PYRO"},
+	{0x100, "RACE_ROCK"},
+	{0x200, "RACE_WINDBEAST"},
+	{0x400, "RACE_PLANT"},
+	{0x800, "RACE_INSECT"},
+	{0x1000, "RACE_THUNDER"},
+	{0x2000, "RACE_DRAGON"},
+	{0x4000, "RACE_BEAST"},
+	{0x8000, "RACE_BEASTWARRIOR"},
+	{0x10000, "RACE_DINOSAUR"},
+	{0x20000, "RACE_FISH"},
+	{0x40000, "RACE_SEASERPENT"},
+	{0x80000, "RACE_REPTILE"},
+	{0x100000, "RACE_PSYCHO"},
+	{0x200000, "RACE_DEVINE"},
+	{0x400000, "RACE_CREATORGOD"},
+	{0x800000, "RACE_WYRM"},
+	{0x1000000, "RACE_CYBERS"},
+}
+
+func (value Race) String() string {
+	return constantString(int64(value), raceNames, "Race")
+}
+
+// Reason 卡片到当前位置的原因
+type Reason int64
+
+const (
+	// 破坏
+	REASON_DESTROY Reason = 0x1
+	// 解放
+	REASON_RELEASE Reason = 0x2
+	// 暂时
+	REASON_TEMPORARY Reason = 0x4
+	// 作为融合/同调/超量素材或用於儀式/升級召喚
+	REASON_MATERIAL Reason = 0x8
+	// 召唤
+	REASON_SUMMON Reason = 0x10
+	// 战斗破坏
+	REASON_BATTLE Reason = 0x20
+	// 效果
+	REASON_EFFECT Reason = 0x40
+	// 用於代價或無法支付代價而破壞
+	REASON_COST Reason = 0x80
+	// 调整（御前试合）
+	REASON_ADJUST Reason = 0x100
+	// 失去装备对象（被破坏）/失去叠放对象（不是被破坏）
+	REASON_LOST_TARGET Reason = 0x200
+	// 规则
+	REASON_RULE Reason = 0x400
+	// 特殊召唤
+	REASON_SPSUMMON Reason = 0x800
+	// 召唤失败
+	REASON_DISSUMMON Reason = 0x1000
+	// 翻转
+	REASON_FLIP Reason = 0x2000
+	// 丢弃
+	REASON_DISCARD Reason = 0x4000
+	// 回復轉換後的傷害
+	REASON_RDAMAGE Reason = 0x8000
+	// 傷害轉換後的回復
+	REASON_RRECOVER Reason = 0x10000
+	// 回到墓地
+	REASON_RETURN Reason = 0x20000
+	// 用於融合召喚
+	REASON_FUSION Reason = 0x40000
+	// 用於同调召喚
+	REASON_SYNCHRO Reason = 0x80000
+	// 用於仪式召喚
+	REASON_RITUAL Reason = 0x100000
+	// 用於超量召喚
+	REASON_XYZ Reason = 0x200000
+	// 代替
+	REASON_REPLACE Reason = 0x1000000
+	// 抽卡
+	REASON_DRAW Reason = 0x2000000
+	// 改变去向（大宇宙，带菌等）
+	REASON_REDIRECT Reason = 0x4000000
+	// 翻开卡组（森罗）
+	REASON_REVEAL Reason = 0x8000000
+)
+
+var reasonValues = map[string]Reason{
+	"destroy":     REASON_DESTROY,
+	"release":     REASON_RELEASE,
+	"temporary":   REASON_TEMPORARY,
+	"material":    REASON_MATERIAL,
+	"summon":      REASON_SUMMON,
+	"battle":      REASON_BATTLE,
+	"effect":      REASON_EFFECT,
+	"cost":        REASON_COST,
+	"adjust":      REASON_ADJUST,
+	"lost_target": REASON_LOST_TARGET,
+	"rule":        REASON_RULE,
+	"spsummon":    REASON_SPSUMMON,
+	"dissummon":   REASON_DISSUMMON,
+	"flip":        REASON_FLIP,
+	"discard":     REASON_DISCARD,
+	"rdamage":     REASON_RDAMAGE,
+	"rrecover":    REASON_RRECOVER,
+	"return":      REASON_RETURN,
+	"fusion":      REASON_FUSION,
+	"synchro":     REASON_SYNCHRO,
+	"ritual":      REASON_RITUAL,
+	"xyz":         REASON_XYZ,
+	"replace":     REASON_REPLACE,
+	"draw":        REASON_DRAW,
+	"redirect":    REASON_REDIRECT,
+	"reveal":      REASON_REVEAL,
+}
+
+var reasonNames = []constantName{
+	{0x1, "REASON_DESTROY"},
+	{0x2, "REASON_RELEASE"},
+	{0x4, "REASON_TEMPORARY"},
+	{0x8, "REASON_MATERIAL"},
+	{0x10, "REASON_SUMMON"},
+	{0x20, "REASON_BATTLE"},
+	{0x40, "REASON_EFFECT"},
+	{0x80, "REASON_COST"},
+	{0x100, "REASON_ADJUST"},
+	{0x200, "REASON_LOST_TARGET"},
+	{0x400, "REASON_RULE"},
+	{0x800, "REASON_SPSUMMON"},
+	{0x1000, "REASON_DISSUMMON"},
+	{0x2000, "REASON_FLIP"},
+	{0x4000, "REASON_DISCARD"},
+	{0x8000, "REASON_RDAMAGE"},
+	{0x10000, "REASON_RRECOVER"},
+	{0x20000, "REASON_RETURN"},
+	{0x40000, "REASON_FUSION"},
+	{0x80000, "REASON_SYNCHRO"},
+	{0x100000, "REASON_RITUAL"},
+	{0x200000, "REASON_XYZ"},
+	{0x1000000, "REASON_REPLACE"},
+	{0x2000000, "REASON_DRAW"},
+	{0x4000000, "REASON_REDIRECT"},
+	{0x8000000, "REASON_REVEAL"},
+}
+
+func (value Reason) String() string {
+	return constantString(int64(value), reasonNames, "Reason")
+}
+
+// SummonType 召唤类型
+type SummonType int64
+
+const (
+	// 通常召唤(EFFECT_SUMMON_PROC,EFFECT_SET_PROC 可用Value修改數值)
+	SUMMON_TYPE_NORMAL SummonType = 0x10000000
+	// 上级召唤
+	SUMMON_TYPE_ADVANCE SummonType = 0x11000000
+	// 再度召唤（二重）
+	SUMMON_TYPE_DUAL SummonType = 0x12000000
+	// 翻转召唤
+	SUMMON_TYPE_FLIP SummonType = 0x20000000
+	// 特殊召唤(EFFECT_SPSUMMON_PROC,EFFECT_SPSUMMON_PROC_G 可用Value修改數值)
+	SUMMON_TYPE_SPECIAL SummonType = 0x40000000
+	// 融合召唤
+	SUMMON_TYPE_FUSION SummonType = 0x43000000
+	// 仪式召唤
+	SUMMON_TYPE_RITUAL SummonType = 0x45000000
+	// 同调召唤
+	SUMMON_TYPE_SYNCHRO SummonType = 0x46000000
+	// 超量召唤
+	SUMMON_TYPE_XYZ SummonType = 0x49000000
+	// 灵摆召唤
+	SUMMON_TYPE_PENDULUM SummonType = 0x4a000000
+)
+
+var summonTypeValues = map[string]SummonType{
+	"normal":   SUMMON_TYPE_NORMAL,
+	"advance":  SUMMON_TYPE_ADVANCE,
+	"dual":     SUMMON_TYPE_DUAL,
+	"flip":     SUMMON_TYPE_FLIP,
+	"special":  SUMMON_TYPE_SPECIAL,
+	"fusion":   SUMMON_TYPE_FUSION,
+	"ritual":   SUMMON_TYPE_RITUAL,
+	"synchro":  SUMMON_TYPE_SYNCHRO,
+	"xyz":      SUMMON_TYPE_XYZ,
+	"pendulum": SUMMON_TYPE_PENDULUM,
+}
+
+var summonTypeNames = []constantName{
+	{0x10000000, "SUMMON_TYPE_NORMAL"},
+	{0x11000000, "SUMMON_TYPE_ADVANCE"},
+	{0x12000000, "SUMMON_TYPE_DUAL"},
+	{0x20000000, "SUMMON_TYPE_FLIP"},
+	{0x40000000, "SUMMON_TYPE_SPECIAL"},
+	{0x43000000, "SUMMON_TYPE_FUSION"},
+	{0x45000000, "SUMMON_TYPE_RITUAL"},
+	{0x46000000, "SUMMON_TYPE_SYNCHRO"},
+	{0x49000000, "SUMMON_TYPE_XYZ"},
+	{0x4a000000, "SUMMON_TYPE_PENDULUM"},
+}
+
+func (value SummonType) String() string {
+	return constantString(int64(value), summonTypeNames, "SummonType")
+}
+
+// Status 卡片当前状态
+type Status int64
+
+const (
+	// 效果被无效
+	STATUS_DISABLED Status = 0x0001
+	// 将变成有效
+	STATUS_TO_ENABLE Status = 0x0002
+	// 将变成无效
+	STATUS_TO_DISABLE Status = 0x0004
+	// 完成正规召唤（解除苏生限制）
+	STATUS_PROC_COMPLETE Status = 0x0008
+	// 在本回合覆盖
+	STATUS_SET_TURN Status = 0x0010
+	// 无等级
+	STATUS_NO_LEVEL Status = 0x0020
+	// 傷害計算結果預計要破壞的怪獸
+	STATUS_BATTLE_RESULT Status = 0x0040
+	// 效果特召處理中
+	STATUS_SPSUMMON_STEP Status = 0x0080
+	// 改变过表示形式
+	STATUS_FORM_CHANGED Status = 0x0100
+	// 召唤中
+	STATUS_SUMMONING Status = 0x0200
+	// 卡片準備就緒(不在移動、召喚、魔法陷阱發動中)
+	STATUS_EFFECT_ENABLED Status = 0x0400
+	// 在本回合召喚/SET
+	STATUS_SUMMON_TURN Status = 0x0800
+	// 破坏确定
+	STATUS_DESTROY_CONFIRMED Status = 0x1000
+	// 連鎖處理完後送去墓地的魔法陷阱
+	STATUS_LEAVE_CONFIRMED Status = 0x2000
+	// 战斗破坏确定後尚未移動
+	STATUS_BATTLE_DESTROYED Status = 0x4000
+	// 复制效果
+	STATUS_COPYING_EFFECT Status = 0x8000
+	// 正在連鎖串中
+	STATUS_CHAINING Status = 0x10000
+	// 召唤无效後尚未移動
+	STATUS_SUMMON_DISABLED Status = 0x20000
+	// 发动无效後尚未移動
+	STATUS_ACTIVATE_DISABLED Status = 0x40000
+	// 效果被替代(红莲霸权)
+	STATUS_EFFECT_REPLACED Status = 0x80000
+	// N/A
+	STATUS_UNION Status = 0x100000
+	// 若其為攻擊者，則攻擊中止
+	STATUS_ATTACK_CANCELED Status = 0x200000
+	// 初始化..
+	STATUS_INITIALIZING Status = 0x400000
+	// 魔法陷阱卡发动過
+	STATUS_ACTIVATED Status = 0x800000
+	// 已改變表示形式(用於STATUS_CONTINUOUS_POS判定)
+	STATUS_JUST_POS Status = 0x1000000
+	// 改變後再次設定成其他表示形式
+	STATUS_CONTINUOUS_POS Status = 0x2000000
+	// 不能play
+	STATUS_FORBIDDEN Status = 0x4000000
+	// 從手牌发动
+	STATUS_ACT_FROM_HAND Status = 0x8000000
+	// 和對手的怪兽戰鬥
+	STATUS_OPPO_BATTLE Status = 0x10000000
+	// 在本回合反转召唤
+	STATUS_FLIP_SUMMON_TURN Status = 0x20000000
+	// 在本回合特殊召唤
+	STATUS_SPSUMMON_TURN Status = 0x40000000
+)
+
+var statusValues = map[string]Status{
+	"disabled":          STATUS_DISABLED,
+	"to_enable":         STATUS_TO_ENABLE,
+	"to_disable":        STATUS_TO_DISABLE,
+	"proc_complete":     STATUS_PROC_COMPLETE,
+	"set_turn":          STATUS_SET_TURN,
+	"no_level":          STATUS_NO_LEVEL,
+	"battle_result":     STATUS_BATTLE_RESULT,
+	"spsummon_step":     STATUS_SPSUMMON_STEP,
+	"form_changed":      STATUS_FORM_CHANGED,
+	"summoning":         STATUS_SUMMONING,
+	"effect_enabled":    STATUS_EFFECT_ENABLED,
+	"summon_turn":       STATUS_SUMMON_TURN,
+	"destroy_confirmed": STATUS_DESTROY_CONFIRMED,
+	"leave_confirmed":   STATUS_LEAVE_CONFIRMED,
+	"battle_destroyed":  STATUS_BATTLE_DESTROYED,
+	"copying_effect":    STATUS_COPYING_EFFECT,
+	"chaining":          STATUS_CHAINING,
+	"summon_disabled":   STATUS_SUMMON_DISABLED,
+	"activate_disabled": STATUS_ACTIVATE_DISABLED,
+	"effect_replaced":   STATUS_EFFECT_REPLACED,
+	"union":             STATUS_UNION,
+	"attack_canceled":   STATUS_ATTACK_CANCELED,
+	"initializing":      STATUS_INITIALIZING,
+	"activated":         STATUS_ACTIVATED,
+	"just_pos":          STATUS_JUST_POS,
+	"continuous_pos":    STATUS_CONTINUOUS_POS,
+	"forbidden":         STATUS_FORBIDDEN,
+	"act_from_hand":     STATUS_ACT_FROM_HAND,
+	"oppo_battle":       STATUS_OPPO_BATTLE,
+	"flip_summon_turn":  STATUS_FLIP_SUMMON_TURN,
+	"spsummon_turn":     STATUS_SPSUMMON_TURN,
+}
+
+var statusNames = []constantName{
+	{0x0001, "STATUS_DISABLED"},
+	{0x0002, "STATUS_TO_ENABLE"},
+	{0x0004, "STATUS_TO_DISABLE"},
+	{0x0008, "STATUS_PROC_COMPLETE"},
+	{0x0010, "STATUS_SET_TURN"},
+	{0x0020, "STATUS_NO_LEVEL"},
+	{0x0040, "STATUS_BATTLE_RESULT"},
+	{0x0080, "STATUS_SPSUMMON_STEP"},
+	{0x0100, "STATUS_FORM_CHANGED"},
+	{0x0200, "STATUS_SUMMONING"},
+	{0x0400, "STATUS_EFFECT_ENABLED"},
+	{0x0800, "STATUS_SUMMON_TURN"},
+	{0x1000, "STATUS_DESTROY_CONFIRMED"},
+	{0x2000, "STATUS_LEAVE_CONFIRMED"},
+	{0x4000, "STATUS_BATTLE_DESTROYED"},
+	{0x8000, "STATUS_COPYING_EFFECT"},
+	{0x10000, "STATUS_CHAINING"},
+	{0x20000, "STATUS_SUMMON_DISABLED"},
+	{0x40000, "STATUS_ACTIVATE_DISABLED"},
+	{0x80000, "STATUS_EFFECT_REPLACED"},
+	{0x100000, "STATUS_UNION"},
+	{0x200000, "STATUS_ATTACK_CANCELED"},
+	{0x400000, "STATUS_INITIALIZING"},
+	{0x800000, "STATUS_ACTIVATED"},
+	{0x1000000, "STATUS_JUST_POS"},
+	{0x2000000, "STATUS_CONTINUOUS_POS"},
+	{0x4000000, "STATUS_FORBIDDEN"},
+	{0x8000000, "STATUS_ACT_FROM_HAND"},
+	{0x10000000, "STATUS_OPPO_BATTLE"},
+	{0x20000000, "STATUS_FLIP_SUMMON_TURN"},
+	{0x40000000, "STATUS_SPSUMMON_TURN"},
+}
+
+func (value Status) String() string {
+	return constantString(int64(value), statusNames, "Status")
+}
+
+// Assume 假定的卡片属性
+type Assume int64
+
+const (
+	ASSUME_CODE      Assume = 1
+	ASSUME_TYPE      Assume = 2
+	ASSUME_LEVEL     Assume = 3
+	ASSUME_RANK      Assume = 4
+	ASSUME_ATTRIBUTE Assume = 5
+	ASSUME_RACE      Assume = 6
+	ASSUME_ATTACK    Assume = 7
+	ASSUME_DEFENSE   Assume = 8
+)
+
+var assumeValues = map[string]Assume{
+	"code":      ASSUME_CODE,
+	"type":      ASSUME_TYPE,
+	"level":     ASSUME_LEVEL,
+	"rank":      ASSUME_RANK,
+	"attribute": ASSUME_ATTRIBUTE,
+	"race":      ASSUME_RACE,
+	"attack":    ASSUME_ATTACK,
+	"defense":   ASSUME_DEFENSE,
+}
+
+var assumeNames = []constantName{
+	{1, "ASSUME_CODE"},
+	{2, "ASSUME_TYPE"},
+	{3, "ASSUME_LEVEL"},
+	{4, "ASSUME_RANK"},
+	{5, "ASSUME_ATTRIBUTE"},
+	{6, "ASSUME_RACE"},
+	{7, "ASSUME_ATTACK"},
+	{8, "ASSUME_DEFENSE"},
+}
+
+func (value Assume) String() string {
+	return constantString(int64(value), assumeNames, "Assume")
+}
+
+// CounterFlag 指示物标记
+type CounterFlag int64
+
+const (
+	// 可以放置在非特定對象的指示物
+	COUNTER_WITHOUT_PERMIT CounterFlag = 0x1000
+	// 在卡片本身放置上述指示物的標記(卡片守衛)
+	COUNTER_NEED_ENABLE CounterFlag = 0x2000
+)
+
+var counterFlagValues = map[string]CounterFlag{
+	"without_permit": COUNTER_WITHOUT_PERMIT,
+	"need_enable":    COUNTER_NEED_ENABLE,
+}
+
+var counterFlagNames = []constantName{
+	{0x1000, "COUNTER_WITHOUT_PERMIT"},
+	{0x2000, "COUNTER_NEED_ENABLE"},
+}
+
+func (value CounterFlag) String() string {
+	return constantString(int64(value), counterFlagNames, "CounterFlag")
+}
+
+// Phase 阶段
+type Phase int64
+
+const (
+	// 抽卡阶段
+	PHASE_DRAW Phase = 0x01
+	// 准备阶段
+	PHASE_STANDBY Phase = 0x02
+	// 主要阶段1
+	PHASE_MAIN1 Phase = 0x04
+	// 战斗阶段开始
+	PHASE_BATTLE_START Phase = 0x08
+	// 战斗步驟
+	PHASE_BATTLE_STEP Phase = 0x10
+	// 伤害步驟
+	PHASE_DAMAGE Phase = 0x20
+	// 伤害计算时
+	PHASE_DAMAGE_CAL Phase = 0x40
+	// 战斗阶段結束
+	PHASE_BATTLE Phase = 0x80
+	// 主要阶段2
+	PHASE_MAIN2 Phase = 0x100
+	// 结束阶段
+	PHASE_END Phase = 0x200
+)
+
+var phaseValues = map[string]Phase{
+	"draw":         PHASE_DRAW,
+	"standby":      PHASE_STANDBY,
+	"main1":        PHASE_MAIN1,
+	"battle_start": PHASE_BATTLE_START,
+	"battle_step":  PHASE_BATTLE_STEP,
+	"damage":       PHASE_DAMAGE,
+	"damage_cal":   PHASE_DAMAGE_CAL,
+	"battle":       PHASE_BATTLE,
+	"main2":        PHASE_MAIN2,
+	"end":          PHASE_END,
+}
+
+var phaseNames = []constantName{
+	{0x01, "PHASE_DRAW"},
+	{0x02, "PHASE_STANDBY"},
+	{0x04, "PHASE_MAIN1"},
+	{0x08, "PHASE_BATTLE_START"},
+	{0x10, "PHASE_BATTLE_STEP"},
+	{0x20, "PHASE_DAMAGE"},
+	{0x40, "PHASE_DAMAGE_CAL"},
+	{0x80, "PHASE_BATTLE"},
+	{0x100, "PHASE_MAIN2"},
+	{0x200, "PHASE_END"},
+}
+
+func (value Phase) String() string {
+	return constantString(int64(value), phaseNames, "Phase")
+}
+
+// Player 玩家
+type Player int64
+
+const (
+	// 2个玩家都不是
+	PLAYER_NONE Player = 2
+	// 2个玩家都是
+	PLAYER_ALL Player = 3
+)
+
+var playerValues = map[string]Player{
+	"none": PLAYER_NONE,
+	"all":  PLAYER_ALL,
+}
+
+var playerNames = []constantName{
+	{2, "PLAYER_NONE"},
+	{3, "PLAYER_ALL"},
+}
+
+func (value Player) String() string {
+	return constantString(int64(value), playerNames, "Player")
+}
+
+// ChainInfo 连锁信息
+type ChainInfo int64
+
+const (
+	// 连锁数
+	CHAININFO_CHAIN_COUNT ChainInfo = 0x01
+	// 连锁的效果
+	CHAININFO_TRIGGERING_EFFECT ChainInfo = 0x02
+	// 连锁的玩家
+	CHAININFO_TRIGGERING_PLAYER ChainInfo = 0x04
+	// 连锁的卡的控制者
+	CHAININFO_TRIGGERING_CONTROLER ChainInfo = 0x08
+	// 连锁的位置
+	CHAININFO_TRIGGERING_LOCATION ChainInfo = 0x10
+	// 连锁的位置的编号（指怪兽和魔陷区的格子）
+	CHAININFO_TRIGGERING_SEQUENCE ChainInfo = 0x20
+	// 连锁的效果的对象（以下3个需要在target函数里设置）
+	CHAININFO_TARGET_CARDS ChainInfo = 0x40
+	// 连锁的效果的对象（玩家）
+	CHAININFO_TARGET_PLAYER ChainInfo = 0x80
+	// 连锁的效果的参数值
+	CHAININFO_TARGET_PARAM ChainInfo = 0x100
+	// 无效的原因
+	CHAININFO_DISABLE_REASON ChainInfo = 0x200
+	// 无效的玩家
+	CHAININFO_DISABLE_PLAYER ChainInfo = 0x400
+	// 连锁ID
+	CHAININFO_CHAIN_ID ChainInfo = 0x800
+	// 连锁类型
+	CHAININFO_TYPE ChainInfo = 0x1000
+	// 连锁额外类型
+	CHAININFO_EXTTYPE ChainInfo = 0x2000
+)
+
+var chainInfoValues = map[string]ChainInfo{
+	"chain_count":          CHAININFO_CHAIN_COUNT,
+	"triggering_effect":    CHAININFO_TRIGGERING_EFFECT,
+	"triggering_player":    CHAININFO_TRIGGERING_PLAYER,
+	"triggering_controler": CHAININFO_TRIGGERING_CONTROLER,
+	"triggering_location":  CHAININFO_TRIGGERING_LOCATION,
+	"triggering_sequence":  CHAININFO_TRIGGERING_SEQUENCE,
+	"target_cards":         CHAININFO_TARGET_CARDS,
+	"target_player":        CHAININFO_TARGET_PLAYER,
+	"target_param":         CHAININFO_TARGET_PARAM,
+	"disable_reason":       CHAININFO_DISABLE_REASON,
+	"disable_player":       CHAININFO_DISABLE_PLAYER,
+	"chain_id":             CHAININFO_CHAIN_ID,
+	"type":                 CHAININFO_TYPE,
+	"exttype":              CHAININFO_EXTTYPE,
+}
+
+var chainInfoNames = []constantName{
+	{0x01, "CHAININFO_CHAIN_COUNT"},
+	{0x02, "CHAININFO_TRIGGERING_EFFECT"},
+	{0x04, "CHAININFO_TRIGGERING_PLAYER"},
+	{0x08, "CHAININFO_TRIGGERING_CONTROLER"},
+	{0x10, "CHAININFO_TRIGGERING_LOCATION"},
+	{0x20, "CHAININFO_TRIGGERING_SEQUENCE"},
+	{0x40, "CHAININFO_TARGET_CARDS"},
+	{0x80, "CHAININFO_TARGET_PLAYER"},
+	{0x100, "CHAININFO_TARGET_PARAM"},
+	{0x200, "CHAININFO_DISABLE_REASON"},
+	{0x400, "CHAININFO_DISABLE_PLAYER"},
+	{0x800, "CHAININFO_CHAIN_ID"},
+	{0x1000, "CHAININFO_TYPE"},
+	{0x2000, "CHAININFO_EXTTYPE"},
+}
+
+func (value ChainInfo) String() string {
+	return constantString(int64(value), chainInfoNames, "ChainInfo")
+}
+
+// Reset 重置条件
+type Reset int64
+
+const (
+	// 自己回合的階段重置
+	RESET_SELF_TURN Reset = 0x10000000
+	// 对方回合的階段重置
+	RESET_OPPO_TURN Reset = 0x20000000
+	// 阶段结束重置(一般和上面那些阶段配合使用)
+	RESET_PHASE Reset = 0x40000000
+	// 连锁结束重置
+	RESET_CHAIN Reset = 0x80000000
+	// 指定的條件下重置(一般和下面这些事件配合使用)
+	RESET_EVENT Reset = 0x1000
+	// 重置Owner為指定卡片的效果
+	RESET_CARD Reset = 0x2000
+	// 重置指定Code的single效果(不含EFFECT_FLAG_SINGLE_RANGE)
+	RESET_CODE Reset = 0x4000
+	// 重置以复制取得的效果
+	RESET_COPY Reset = 0x8000
+	// 效果无效重置(只適用於owner==handler的效果)
+	RESET_DISABLE Reset = 0x00010000
+	// 变里侧重置
+	RESET_TURN_SET Reset = 0x00020000
+	// 去墓地重置(以下皆為事件觸發前重置)
+	RESET_TOGRAVE Reset = 0x00040000
+	// 除外重置
+	RESET_REMOVE Reset = 0x00080000
+	// 暂时除外重置
+	RESET_TEMP_REMOVE Reset = 0x00100000
+	// 回手牌或加入手牌重置
+	RESET_TOHAND Reset = 0x00200000
+	// 回卡组重置
+	RESET_TODECK Reset = 0x00400000
+	// 从场上移到其他位置/超量叠放重置
+	RESET_LEAVE Reset = 0x00800000
+	// 到场上重置(move_to_field())
+	RESET_TOFIELD Reset = 0x01000000
+	// 控制者变更重置
+	RESET_CONTROL Reset = 0x02000000
+	// 超量叠放重置
+	RESET_OVERLAY Reset = 0x04000000
+	// 从怪兽区到魔法区，或者从魔法区到怪兽区(move_to_field()、寶玉獸)
+	RESET_MSCHANGE Reset = 0x08000000
+	// RESET_TOFIELD+RESET_LEAVE+RESET_TODECK+RESET_TOHAND+RESET_TEMP_REMOVE+RESET_REMOVE+RESET_TOGRAVE+RESET_TURN_SET
+	RESETS_STANDARD Reset = 0x1fe0000
+	// 0x1fe0000+RESET_DISABLE
+	RESETS_STANDARD_DISABLE Reset = 0x1ff0000
+)
+
+var resetValues = map[string]Reset{
+	"self_turn":        RESET_SELF_TURN,
+	"oppo_turn":        RESET_OPPO_TURN,
+	"phase":            RESET_PHASE,
+	"chain":            RESET_CHAIN,
+	"event":            RESET_EVENT,
+	"card":             RESET_CARD,
+	"code":             RESET_CODE,
+	"copy":             RESET_COPY,
+	"disable":          RESET_DISABLE,
+	"turn_set":         RESET_TURN_SET,
+	"tograve":          RESET_TOGRAVE,
+	"remove":           RESET_REMOVE,
+	"temp_remove":      RESET_TEMP_REMOVE,
+	"tohand":           RESET_TOHAND,
+	"todeck":           RESET_TODECK,
+	"leave":            RESET_LEAVE,
+	"tofield":          RESET_TOFIELD,
+	"control":          RESET_CONTROL,
+	"overlay":          RESET_OVERLAY,
+	"mschange":         RESET_MSCHANGE,
+	"standard":         RESETS_STANDARD,
+	"standard_disable": RESETS_STANDARD_DISABLE,
+}
+
+var resetNames = []constantName{
+	{0x10000000, "RESET_SELF_TURN"},
+	{0x20000000, "RESET_OPPO_TURN"},
+	{0x40000000, "RESET_PHASE"},
+	{0x80000000, "RESET_CHAIN"},
+	{0x1000, "RESET_EVENT"},
+	{0x2000, "RESET_CARD"},
+	{0x4000, "RESET_CODE"},
+	{0x8000, "RESET_COPY"},
+	{0x00010000, "RESET_DISABLE"},
+	{0x00020000, "RESET_TURN_SET"},
+	{0x00040000, "RESET_TOGRAVE"},
+	{0x00080000, "RESET_REMOVE"},
+	{0x00100000, "RESET_TEMP_REMOVE"},
+	{0x00200000, "RESET_TOHAND"},
+	{0x00400000, "RESET_TODECK"},
+	{0x00800000, "RESET_LEAVE"},
+	{0x01000000, "RESET_TOFIELD"},
+	{0x02000000, "RESET_CONTROL"},
+	{0x04000000, "RESET_OVERLAY"},
+	{0x08000000, "RESET_MSCHANGE"},
+	{0x1fe0000, "RESETS_STANDARD"},
+	{0x1ff0000, "RESETS_STANDARD_DISABLE"},
+}
+
+func (value Reset) String() string {
+	return constantString(int64(value), resetNames, "Reset")
+}
+
+// EffectType 效果类型
+type EffectType int64
+
+const (
+	// 自己状态变化时触发
+	EFFECT_TYPE_SINGLE EffectType = 0x0001
+	// 场上所有卡状态变化时触发
+	EFFECT_TYPE_FIELD EffectType = 0x0002
+	// 装备效果
+	EFFECT_TYPE_EQUIP EffectType = 0x0004
+	// 触发型，以下類型會自動添加此屬性（对峙的G）
+	EFFECT_TYPE_ACTIONS EffectType = 0x0008
+	// 魔陷发动
+	EFFECT_TYPE_ACTIVATE EffectType = 0x0010
+	// 翻转效果
+	EFFECT_TYPE_FLIP EffectType = 0x0020
+	// 起动效果
+	EFFECT_TYPE_IGNITION EffectType = 0x0040
+	// 诱发选发效果
+	EFFECT_TYPE_TRIGGER_O EffectType = 0x0080
+	// 诱发即时效果
+	EFFECT_TYPE_QUICK_O EffectType = 0x0100
+	// 诱发必发效果
+	EFFECT_TYPE_TRIGGER_F EffectType = 0x0200
+	// 诱发即时必发效果（熊猫龙等）
+	EFFECT_TYPE_QUICK_F EffectType = 0x0400
+	// 由事件觸發的輔助用效果/永續效果
+	EFFECT_TYPE_CONTINUOUS EffectType = 0x0800
+	EFFECT_TYPE_XMATERIAL  EffectType = 0x1000
+)
+
+var effectTypeValues = map[string]EffectType{
+	"single":     EFFECT_TYPE_SINGLE,
+	"field":      EFFECT_TYPE_FIELD,
+	"equip":      EFFECT_TYPE_EQUIP,
+	"actions":    EFFECT_TYPE_ACTIONS,
+	"activate":   EFFECT_TYPE_ACTIVATE,
+	"flip":       EFFECT_TYPE_FLIP,
+	"ignition":   EFFECT_TYPE_IGNITION,
+	"trigger_o":  EFFECT_TYPE_TRIGGER_O,
+	"quick_o":    EFFECT_TYPE_QUICK_O,
+	"trigger_f":  EFFECT_TYPE_TRIGGER_F,
+	"quick_f":    EFFECT_TYPE_QUICK_F,
+	"continuous": EFFECT_TYPE_CONTINUOUS,
+	"xmaterial":  EFFECT_TYPE_XMATERIAL,
+}
+
+var effectTypeNames = []constantName{
+	{0x0001, "EFFECT_TYPE_SINGLE"},
+	{0x0002, "EFFECT_TYPE_FIELD"},
+	{0x0004, "EFFECT_TYPE_EQUIP"},
+	{0x0008, "EFFECT_TYPE_ACTIONS"},
+	{0x0010, "EFFECT_TYPE_ACTIVATE"},
+	{0x0020, "EFFECT_TYPE_FLIP"},
+	{0x0040, "EFFECT_TYPE_IGNITION"},
+	{0x0080, "EFFECT_TYPE_TRIGGER_O"},
+	{0x0100, "EFFECT_TYPE_QUICK_O"},
+	{0x0200, "EFFECT_TYPE_TRIGGER_F"},
+	{0x0400, "EFFECT_TYPE_QUICK_F"},
+	{0x0800, "EFFECT_TYPE_CONTINUOUS"},
+	{0x1000, "EFFECT_TYPE_XMATERIAL"},
+}
+
+func (value EffectType) String() string {
+	return constantString(int64(value), effectTypeNames, "EffectType")
+}
+
+// EffectFlag 效果的特殊性质
+type EffectFlag int64
+
+const (
+	// 可以发动的
+	EFFECT_FLAG_INITIAL EffectFlag = 0x0001
+	// 此效果的Value属性是函数
+	EFFECT_FLAG_FUNC_VALUE EffectFlag = 0x0002
+	// 发动次数限制
+	EFFECT_FLAG_COUNT_LIMIT EffectFlag = 0x0004
+	// 此效果是注册给全局环境的
+	EFFECT_FLAG_FIELD_ONLY EffectFlag = 0x0008
+	// 取对象效果
+	EFFECT_FLAG_CARD_TARGET EffectFlag = 0x0010
+	// 影响所有区域的卡（禁止令 大宇宙 王宫的铁壁）
+	EFFECT_FLAG_IGNORE_RANGE EffectFlag = 0x0020
+	// Target Range不会因为控制权的改变而改变
+	EFFECT_FLAG_ABSOLUTE_TARGET EffectFlag = 0x0040
+	// 无视效果免疫
+	EFFECT_FLAG_IGNORE_IMMUNE EffectFlag = 0x0080
+	// 影响场上里侧的卡/裡側狀態可發動
+	EFFECT_FLAG_SET_AVAILABLE EffectFlag = 0x0100
+	// 含有"此效果不會被無效"的敘述
+	EFFECT_FLAG_CANNOT_NEGATE EffectFlag = 0x0200
+	// 不会被无效
+	EFFECT_FLAG_CANNOT_DISABLE EffectFlag = 0x0400
+	// 以玩家为对象
+	EFFECT_FLAG_PLAYER_TARGET EffectFlag = 0x0800
+	// 双方都能使用（部分场地，弹压）
+	EFFECT_FLAG_BOTH_SIDE EffectFlag = 0x1000
+	// 若由复制的效果產生則继承其Reset属性
+	EFFECT_FLAG_COPY_INHERIT EffectFlag = 0x2000
+	// 可以在伤害步骤发动
+	EFFECT_FLAG_DAMAGE_STEP EffectFlag = 0x4000
+	// 可以在伤害计算时发动
+	EFFECT_FLAG_DAMAGE_CAL EffectFlag = 0x8000
+	// 場合型誘發效果、用於永續效果的EFFECT_TYPE_CONTINUOUS、神之化身/恐惧之源的攻击力变化最后计算
+	EFFECT_FLAG_DELAY EffectFlag = 0x10000
+	// 只对自己有效
+	EFFECT_FLAG_SINGLE_RANGE EffectFlag = 0x20000
+	// 不能复制（效果外文本）
+	EFFECT_FLAG_UNCOPYABLE EffectFlag = 0x40000
+	// 誓约效果
+	EFFECT_FLAG_OATH EffectFlag = 0x80000
+	// 指定召喚/规则特殊召唤的位置和表示形式(熔岩魔神)
+	EFFECT_FLAG_SPSUM_PARAM EffectFlag = 0x100000
+	// 神之化身的攻击力重复计算
+	EFFECT_FLAG_REPEAT EffectFlag = 0x200000
+	// 发条等“这张卡在场上只能发动一次”的效果
+	EFFECT_FLAG_NO_TURN_RESET EffectFlag = 0x400000
+	// 视为对方玩家的效果（动作？）
+	EFFECT_FLAG_EVENT_PLAYER EffectFlag = 0x800000
+	// 持續成為對象
+	EFFECT_FLAG_OWNER_RELATE EffectFlag = 0x1000000
+	// 战斗破坏确定时效果也适用（纳祭之魔 地狱战士）
+	EFFECT_FLAG_AVAILABLE_BD EffectFlag = 0x2000000
+	// 客户端提示
+	EFFECT_FLAG_CLIENT_HINT EffectFlag = 0x4000000
+	// 同一组连锁只能发动一次
+	EFFECT_FLAG_CHAIN_UNIQUE EffectFlag = 0x8000000
+	// N/A
+	EFFECT_FLAG_NAGA EffectFlag = 0x10000000
+	// N/A
+	EFFECT_FLAG_COF EffectFlag = 0x20000000
+	// 以卡为COST的诱发效果需要使用
+	EFFECT_FLAG_CVAL_CHECK EffectFlag = 0x40000000
+	// 卡在发动时效果就立即适用（卡通王國）
+	EFFECT_FLAG_IMMEDIATELY_APPLY EffectFlag = 0x80000000
+)
+
+var effectFlagValues = map[string]EffectFlag{
+	"initial":           EFFECT_FLAG_INITIAL,
+	"func_value":        EFFECT_FLAG_FUNC_VALUE,
+	"count_limit":       EFFECT_FLAG_COUNT_LIMIT,
+	"field_only":        EFFECT_FLAG_FIELD_ONLY,
+	"card_target":       EFFECT_FLAG_CARD_TARGET,
+	"ignore_range":      EFFECT_FLAG_IGNORE_RANGE,
+	"absolute_target":   EFFECT_FLAG_ABSOLUTE_TARGET,
+	"ignore_immune":     EFFECT_FLAG_IGNORE_IMMUNE,
+	"set_available":     EFFECT_FLAG_SET_AVAILABLE,
+	"cannot_negate":     EFFECT_FLAG_CANNOT_NEGATE,
+	"cannot_disable":    EFFECT_FLAG_CANNOT_DISABLE,
+	"player_target":     EFFECT_FLAG_PLAYER_TARGET,
+	"both_side":         EFFECT_FLAG_BOTH_SIDE,
+	"copy_inherit":      EFFECT_FLAG_COPY_INHERIT,
+	"damage_step":       EFFECT_FLAG_DAMAGE_STEP,
+	"damage_cal":        EFFECT_FLAG_DAMAGE_CAL,
+	"delay":             EFFECT_FLAG_DELAY,
+	"single_range":      EFFECT_FLAG_SINGLE_RANGE,
+	"uncopyable":        EFFECT_FLAG_UNCOPYABLE,
+	"oath":              EFFECT_FLAG_OATH,
+	"spsum_param":       EFFECT_FLAG_SPSUM_PARAM,
+	"repeat":            EFFECT_FLAG_REPEAT,
+	"no_turn_reset":     EFFECT_FLAG_NO_TURN_RESET,
+	"event_player":      EFFECT_FLAG_EVENT_PLAYER,
+	"owner_relate":      EFFECT_FLAG_OWNER_RELATE,
+	"available_bd":      EFFECT_FLAG_AVAILABLE_BD,
+	"client_hint":       EFFECT_FLAG_CLIENT_HINT,
+	"chain_unique":      EFFECT_FLAG_CHAIN_UNIQUE,
+	"naga":              EFFECT_FLAG_NAGA,
+	"cof":               EFFECT_FLAG_COF,
+	"cval_check":        EFFECT_FLAG_CVAL_CHECK,
+	"immediately_apply": EFFECT_FLAG_IMMEDIATELY_APPLY,
+}
+
+var effectFlagNames = []constantName{
+	{0x0001, "EFFECT_FLAG_INITIAL"},
+	{0x0002, "EFFECT_FLAG_FUNC_VALUE"},
+	{0x0004, "EFFECT_FLAG_COUNT_LIMIT"},
+	{0x0008, "EFFECT_FLAG_FIELD_ONLY"},
+	{0x0010, "EFFECT_FLAG_CARD_TARGET"},
+	{0x0020, "EFFECT_FLAG_IGNORE_RANGE"},
+	{0x0040, "EFFECT_FLAG_ABSOLUTE_TARGET"},
+	{0x0080, "EFFECT_FLAG_IGNORE_IMMUNE"},
+	{0x0100, "EFFECT_FLAG_SET_AVAILABLE"},
+	{0x0200, "EFFECT_FLAG_CANNOT_NEGATE"},
+	{0x0400, "EFFECT_FLAG_CANNOT_DISABLE"},
+	{0x0800, "EFFECT_FLAG_PLAYER_TARGET"},
+	{0x1000, "EFFECT_FLAG_BOTH_SIDE"},
+	{0x2000, "EFFECT_FLAG_COPY_INHERIT"},
+	{0x4000, "EFFECT_FLAG_DAMAGE_STEP"},
+	{0x8000, "EFFECT_FLAG_DAMAGE_CAL"},
+	{0x10000, "EFFECT_FLAG_DELAY"},
+	{0x20000, "EFFECT_FLAG_SINGLE_RANGE"},
+	{0x40000, "EFFECT_FLAG_UNCOPYABLE"},
+	{0x80000, "EFFECT_FLAG_OATH"},
+	{0x100000, "EFFECT_FLAG_SPSUM_PARAM"},
+	{0x200000, "EFFECT_FLAG_REPEAT"},
+	{0x400000, "EFFECT_FLAG_NO_TURN_RESET"},
+	{0x800000, "EFFECT_FLAG_EVENT_PLAYER"},
+	{0x1000000, "EFFECT_FLAG_OWNER_RELATE"},
+	{0x2000000, "EFFECT_FLAG_AVAILABLE_BD"},
+	{0x4000000, "EFFECT_FLAG_CLIENT_HINT"},
+	{0x8000000, "EFFECT_FLAG_CHAIN_UNIQUE"},
+	{0x10000000, "EFFECT_FLAG_NAGA"},
+	{0x20000000, "EFFECT_FLAG_COF"},
+	{0x40000000, "EFFECT_FLAG_CVAL_CHECK"},
+	{0x80000000, "EFFECT_FLAG_IMMEDIATELY_APPLY"},
+}
+
+func (value EffectFlag) String() string {
+	return constantString(int64(value), effectFlagNames, "EffectFlag")
+}
+
+// EffectFlag2 效果的特殊性质（第二组）
+type EffectFlag2 int64
+
+const (
+	// 特殊情况时发动不会被无效（神卡纳迦的特殊处理）
+	EFFECT_FLAG2_NAGA EffectFlag2 = 0x0001
+	// 通常魔法卡在MP1以外发动（邪恶的仪式的特殊处理）
+	EFFECT_FLAG2_COF EffectFlag2 = 0x0002
+)
+
+var effectFlag2Values = map[string]EffectFlag2{
+	"naga": EFFECT_FLAG2_NAGA,
+	"cof":  EFFECT_FLAG2_COF,
+}
+
+var effectFlag2Names = []constantName{
+	{0x0001, "EFFECT_FLAG2_NAGA"},
+	{0x0002, "EFFECT_FLAG2_COF"},
+}
+
+func (value EffectFlag2) String() string {
+	return constantString(int64(value), effectFlag2Names, "EffectFlag2")
+}
+
+// CountCode 发动次数限制的代码
+type CountCode int64
+
+const (
+	// 发动次数限制(誓约次数, 发动被无效不计数)
+	EFFECT_COUNT_CODE_OATH CountCode = 0x10000000
+	// 决斗中使用次数
+	EFFECT_COUNT_CODE_DUEL CountCode = 0x20000000
+	// 同一张卡的多个效果公共使用次数
+	EFFECT_COUNT_CODE_SINGLE CountCode = 0x1
+)
+
+var countCodeValues = map[string]CountCode{
+	"oath":   EFFECT_COUNT_CODE_OATH,
+	"duel":   EFFECT_COUNT_CODE_DUEL,
+	"single": EFFECT_COUNT_CODE_SINGLE,
+}
+
+var countCodeNames = []constantName{
+	{0x10000000, "EFFECT_COUNT_CODE_OATH"},
+	{0x20000000, "EFFECT_COUNT_CODE_DUEL"},
+	{0x1, "EFFECT_COUNT_CODE_SINGLE"},
+}
+
+func (value CountCode) String() string {
+	return constantString(int64(value), countCodeNames, "CountCode")
+}
+
+// EffectCode 永续性效果的效果代码
+type EffectCode int64
+
+const (
+	// 效果免疫
+	EFFECT_IMMUNE_EFFECT EffectCode = 1
+	// 效果无效（技能抽取）
+	EFFECT_DISABLE EffectCode = 2
+	// 效果不能被无效
+	EFFECT_CANNOT_DISABLE EffectCode = 3
+	// 设置控制权
+	EFFECT_SET_CONTROL EffectCode = 4
+	// 不能改变控制权
+	EFFECT_CANNOT_CHANGE_CONTROL EffectCode = 5
+	// 玩家不能发动效果
+	EFFECT_CANNOT_ACTIVATE EffectCode = 6
+	// 卡不能发动效果
+	EFFECT_CANNOT_TRIGGER EffectCode = 7
+	// 效果无效（聖杯）
+	EFFECT_DISABLE_EFFECT EffectCode = 8
+	// 在連鎖串中無效(processor.cpp)
+	EFFECT_DISABLE_CHAIN EffectCode = 9
+	// 陷阱怪兽无效
+	EFFECT_DISABLE_TRAPMONSTER EffectCode = 10
+	// 发动不能被无效
+	EFFECT_CANNOT_INACTIVATE EffectCode = 12
+	// 效果處理時不能被无效
+	EFFECT_CANNOT_DISEFFECT EffectCode = 13
+	// 不能改变表示形式
+	EFFECT_CANNOT_CHANGE_POSITION EffectCode = 14
+	// 陷阱可以从手牌发动
+	EFFECT_TRAP_ACT_IN_HAND EffectCode = 15
+	// 陷阱可以在盖放的回合发动
+	EFFECT_TRAP_ACT_IN_SET_TURN EffectCode = 16
+	// X回合内留在场上（光之护封剑等）
+	EFFECT_REMAIN_FIELD EffectCode = 17
+	// 怪兽可以在魔陷区放置
+	EFFECT_MONSTER_SSET EffectCode = 18
+	// 不能召唤怪兽
+	EFFECT_CANNOT_SUMMON EffectCode = 20
+	// 不能翻转召唤怪兽
+	EFFECT_CANNOT_FLIP_SUMMON EffectCode = 21
+	// 不能特殊召唤怪兽
+	EFFECT_CANNOT_SPECIAL_SUMMON EffectCode = 22
+	// 不能覆盖怪兽
+	EFFECT_CANNOT_MSET EffectCode = 23
+	// 不能覆盖魔陷
+	EFFECT_CANNOT_SSET EffectCode = 24
+	// 不能抽卡
+	EFFECT_CANNOT_DRAW EffectCode = 25
+	// 召唤不会无效
+	EFFECT_CANNOT_DISABLE_SUMMON EffectCode = 26
+	// 特殊召唤不会无效
+	EFFECT_CANNOT_DISABLE_SPSUMMON EffectCode = 27
+	// 限制每回合放置怪兽次数
+	EFFECT_SET_SUMMON_COUNT_LIMIT EffectCode = 28
+	// 增加召唤（通常召唤）次数
+	EFFECT_EXTRA_SUMMON_COUNT EffectCode = 29
+	// 特殊召唤条件
+	EFFECT_SPSUMMON_CONDITION EffectCode = 30
+	// 有苏生限制的怪獸
+	EFFECT_REVIVE_LIMIT EffectCode = 31
+	// 召唤规则效果
+	EFFECT_SUMMON_PROC EffectCode = 32
+	// 召唤规则限制
+	EFFECT_LIMIT_SUMMON_PROC EffectCode = 33
+	// 特殊召唤规则
+	EFFECT_SPSUMMON_PROC EffectCode = 34
+	// 增加盖放（通常召唤）次数
+	EFFECT_EXTRA_SET_COUNT EffectCode = 35
+	// 放置（通常召唤）规则
+	EFFECT_SET_PROC EffectCode = 36
+	// 放置（通常召唤）规则限制
+	EFFECT_LIMIT_SET_PROC EffectCode = 37
+	// 神圣光辉（魔术礼帽）
+	EFFECT_DEVINE_LIGHT EffectCode = 38
+	// 翻转召唤不会无效
+	EFFECT_CANNOT_DISABLE_FLIP_SUMMON EffectCode = 39
+	// 不会被破坏
+	EFFECT_INDESTRUCTABLE EffectCode = 40
+	// 不会被效果破坏
+	EFFECT_INDESTRUCTABLE_EFFECT EffectCode = 41
+	// 不会被战斗破坏
+	EFFECT_INDESTRUCTABLE_BATTLE EffectCode = 42
+	// 不能做上级召唤的祭品
+	EFFECT_UNRELEASABLE_SUM EffectCode = 43
+	// 不能做上级召唤以外的祭品
+	EFFECT_UNRELEASABLE_NONSUM EffectCode = 44
+	// 必選的代替破壞(此卡被破壞時用其他卡代替)
+	EFFECT_DESTROY_SUBSTITUTE EffectCode = 45
+	// 不能进行解放行为
+	EFFECT_CANNOT_RELEASE EffectCode = 46
+	// 一回合几次不会被破坏
+	EFFECT_INDESTRUCTABLE_COUNT EffectCode = 47
+	// 不能被解放
+	EFFECT_UNRELEASABLE_EFFECT EffectCode = 48
+	// 可選的代替破壞(將破壞改成其他動作)
+	EFFECT_DESTROY_REPLACE EffectCode = 50
+	// 代替解放
+	EFFECT_RELEASE_REPLACE EffectCode = 51
+	// 可以不送去XX而送去OO（甜点城堡等）
+	EFFECT_SEND_REPLACE EffectCode = 52
+	// 不能丢弃手牌
+	EFFECT_CANNOT_DISCARD_HAND EffectCode = 55
+	// 不能把卡组的卡送去墓地
+	EFFECT_CANNOT_DISCARD_DECK EffectCode = 56
+	// 不能作为COST使用
+	EFFECT_CANNOT_USE_AS_COST EffectCode = 57
+	// 不能放置counter
+	EFFECT_CANNOT_PLACE_COUNTER EffectCode = 58
+	// 离场时重新指定去向
+	EFFECT_LEAVE_FIELD_REDIRECT EffectCode = 60
+	// 回手牌时重新指定去向
+	EFFECT_TO_HAND_REDIRECT EffectCode = 61
+	// 回卡组时重新指定去向
+	EFFECT_TO_DECK_REDIRECT EffectCode = 62
+	// 去墓地时重新指定去向
+	EFFECT_TO_GRAVE_REDIRECT EffectCode = 63
+	// 除外时重新指定去向
+	EFFECT_REMOVE_REDIRECT EffectCode = 64
+	// 不能加入手牌
+	EFFECT_CANNOT_TO_HAND EffectCode = 65
+	// 不能回卡组
+	EFFECT_CANNOT_TO_DECK EffectCode = 66
+	// 不能除外
+	EFFECT_CANNOT_REMOVE EffectCode = 67
+	// 不能去墓地
+	EFFECT_CANNOT_TO_GRAVE EffectCode = 68
+	// 不能变里侧
+	EFFECT_CANNOT_TURN_SET EffectCode = 69
+	// 不能成为攻击对象
+	EFFECT_CANNOT_BE_BATTLE_TARGET EffectCode = 70
+	// 不能成为效果对象
+	EFFECT_CANNOT_BE_EFFECT_TARGET EffectCode = 71
+	// 不能成为攻击对象-鶸型（传说的渔人）
+	EFFECT_IGNORE_BATTLE_TARGET EffectCode = 72
+	// 不能直接攻击
+	EFFECT_CANNOT_DIRECT_ATTACK EffectCode = 73
+	// 可以直接攻击
+	EFFECT_DIRECT_ATTACK EffectCode = 74
+	// 二重状态
+	EFFECT_DUAL_STATUS EffectCode = 75
+	// 装备对象限制
+	EFFECT_EQUIP_LIMIT EffectCode = 76
+	// 可以再度召唤
+	EFFECT_DUAL_SUMMONABLE EffectCode = 77
+	// 伤害变回复
+	EFFECT_REVERSE_DAMAGE EffectCode = 80
+	// 回复变伤害
+	EFFECT_REVERSE_RECOVER EffectCode = 81
+	// 改变伤害数值
+	EFFECT_CHANGE_DAMAGE EffectCode = 82
+	// 反射伤害
+	EFFECT_REFLECT_DAMAGE EffectCode = 83
+	// 不能攻击
+	EFFECT_CANNOT_ATTACK EffectCode = 85
+	// 不能攻击宣言
+	EFFECT_CANNOT_ATTACK_ANNOUNCE EffectCode = 86
+	// 不会被卡的效果变成守备表示（攻击性云魔物）
+	EFFECT_CANNOT_CHANGE_POS_E EffectCode = 87
+	// 发动代价（魔力之枷）
+	EFFECT_ACTIVATE_COST EffectCode = 90
+	// 召唤代价
+	EFFECT_SUMMON_COST EffectCode = 91
+	// 特殊召唤代价（暴君龙）
+	EFFECT_SPSUMMON_COST EffectCode = 92
+	// 翻转召唤代价
+	EFFECT_FLIPSUMMON_COST EffectCode = 93
+	// 怪兽放置代价
+	EFFECT_MSET_COST EffectCode = 94
+	// 魔陷放置代价
+	EFFECT_SSET_COST EffectCode = 95
+	// 攻击代价（霞之谷猎鹰）
+	EFFECT_ATTACK_COST EffectCode = 96
+	// 改变攻击力（攻击力增加/减少）
+	EFFECT_UPDATE_ATTACK EffectCode = 100
+	// 设置攻击力(永續型效果、攻擊力變成X特殊召喚)
+	EFFECT_SET_ATTACK EffectCode = 101
+	// 设置最终攻击力(所有入連鎖的改變攻擊力)
+	EFFECT_SET_ATTACK_FINAL EffectCode = 102
+	// 设置原本攻击力
+	EFFECT_SET_BASE_ATTACK EffectCode = 103
+	// 改变防御力
+	EFFECT_UPDATE_DEFENSE EffectCode = 104
+	// 设置防御力
+	EFFECT_SET_DEFENSE EffectCode = 105
+	// 设置最终防御力
+	EFFECT_SET_DEFENSE_FINAL EffectCode = 106
+	// 设置原本防御力
+	EFFECT_SET_BASE_DEFENSE EffectCode = 107
+	// 倒置改变攻击力、防御力（天邪鬼）
+	EFFECT_REVERSE_UPDATE EffectCode = 108
+	// 交换攻防(超級漏洞人)
+	EFFECT_SWAP_AD EffectCode = 109
+	// 交换原本攻防
+	EFFECT_SWAP_BASE_AD EffectCode = 110
+	// 設定最終攻擊力(用於交換攻防)
+	EFFECT_SWAP_ATTACK_FINAL EffectCode = 111
+	// 設定最終防禦力(用於交換攻防)
+	EFFECT_SWAP_DEFENSE_FINAL EffectCode = 112
+	// 增加卡名
+	EFFECT_ADD_CODE EffectCode = 113
+	// 改变卡名
+	EFFECT_CHANGE_CODE EffectCode = 114
+	// 增加卡片种类（types）
+	EFFECT_ADD_TYPE EffectCode = 115
+	// 删除卡片种类
+	EFFECT_REMOVE_TYPE EffectCode = 116
+	// 改变卡片种类
+	EFFECT_CHANGE_TYPE EffectCode = 117
+	// 增加种族
+	EFFECT_ADD_RACE EffectCode = 120
+	// 删除种族
+	EFFECT_REMOVE_RACE EffectCode = 121
+	// 改变种族
+	EFFECT_CHANGE_RACE EffectCode = 122
+	// 增加属性
+	EFFECT_ADD_ATTRIBUTE EffectCode = 125
+	// 删除属性
+	EFFECT_REMOVE_ATTRIBUTE EffectCode = 126
+	// 改变属性
+	EFFECT_CHANGE_ATTRIBUTE EffectCode = 127
+	// 改变等级
+	EFFECT_UPDATE_LEVEL EffectCode = 130
+	// 设置等级
+	EFFECT_CHANGE_LEVEL EffectCode = 131
+	// 改变阶级
+	EFFECT_UPDATE_RANK EffectCode = 132
+	// 设置阶级
+	EFFECT_CHANGE_RANK EffectCode = 133
+	// 改变左刻度
+	EFFECT_UPDATE_LSCALE EffectCode = 134
+	// 设置左刻度
+	EFFECT_CHANGE_LSCALE EffectCode = 135
+	// 改变右刻度
+	EFFECT_UPDATE_RSCALE EffectCode = 136
+	// 设置右刻度
+	EFFECT_CHANGE_RSCALE EffectCode = 137
+	// 設定表示形式
+	EFFECT_SET_POSITION EffectCode = 140
+	// 不入連鎖的破壞（罪系列等）
+	EFFECT_SELF_DESTROY EffectCode = 141
+	// 不入連鎖的送墓
+	EFFECT_SELF_TOGRAVE EffectCode = 142
+	// 可以作为2个祭品
+	EFFECT_DOUBLE_TRIBUTE EffectCode = 150
+	// 减少祭品
+	EFFECT_DECREASE_TRIBUTE EffectCode = 151
+	// 减少放置怪兽的祭品
+	EFFECT_DECREASE_TRIBUTE_SET EffectCode = 152
+	// 必須使用的代替解放（灵魂交错）
+	EFFECT_EXTRA_RELEASE EffectCode = 153
+	// 祭品限制
+	EFFECT_TRIBUTE_LIMIT EffectCode = 154
+	// 代替召唤解放（帝王的烈旋）
+	EFFECT_EXTRA_RELEASE_SUM EffectCode = 155
+	EFFECT_TRIPLE_TRIBUTE    EffectCode = 156
+	// 公开手牌
+	EFFECT_PUBLIC EffectCode = 160
+	// 允许放置指示物类型
+	EFFECT_COUNTER_PERMIT EffectCode = 0x10000
+	// 允许放置指示物数量
+	EFFECT_COUNTER_LIMIT EffectCode = 0x20000
+	// 代替取除指示物
+	EFFECT_RCOUNTER_REPLACE EffectCode = 0x30000
+	// 改变生命值代价數值
+	EFFECT_LPCOST_CHANGE EffectCode = 170
+	// 以其他動作代替生命值代价
+	EFFECT_LPCOST_REPLACE EffectCode = 171
+	// 跳过抽卡阶段
+	EFFECT_SKIP_DP EffectCode = 180
+	// 跳过准备阶段
+	EFFECT_SKIP_SP EffectCode = 181
+	// 跳过主要阶段1
+	EFFECT_SKIP_M1 EffectCode = 182
+	// 跳过战斗阶段
+	EFFECT_SKIP_BP EffectCode = 183
+	// 跳过主要阶段2
+	EFFECT_SKIP_M2 EffectCode = 184
+	// 不能进入战斗阶段
+	EFFECT_CANNOT_BP EffectCode = 185
+	// 不能进入主要阶段2
+	EFFECT_CANNOT_M2 EffectCode = 186
+	// 不能进入结束阶段
+	EFFECT_CANNOT_EP EffectCode = 187
+	// 跳过整个回合
+	EFFECT_SKIP_TURN EffectCode = 188
+	// 可以守备表示攻击
+	EFFECT_DEFENSE_ATTACK EffectCode = 190
+	// 必须攻击
+	EFFECT_MUST_ATTACK EffectCode = 191
+	// 必须第一个攻击
+	EFFECT_FIRST_ATTACK EffectCode = 192
+	// 可以攻击所有怪兽
+	EFFECT_ATTACK_ALL EffectCode = 193
+	// 增加攻击次数
+	EFFECT_EXTRA_ATTACK EffectCode = 194
+	// 必须攻击此卡
+	EFFECT_MUST_BE_ATTACKED EffectCode = 195
+	// 只能攻击此卡
+	EFFECT_ONLY_BE_ATTACKED EffectCode = 196
+	// 攻击已被無效(Duel.NegateAttack()成功的標記)
+	EFFECT_ATTACK_DISABLED EffectCode = 197
+	// 不会给对方造成战斗伤害
+	EFFECT_NO_BATTLE_DAMAGE EffectCode = 200
+	// 不会对自己造成战斗伤害
+	EFFECT_AVOID_BATTLE_DAMAGE EffectCode = 201
+	// 反弹战斗伤害
+	EFFECT_REFLECT_BATTLE_DAMAGE EffectCode = 202
+	// 贯穿伤害
+	EFFECT_PIERCE EffectCode = 203
+	// 战斗破坏时重新指定去向
+	EFFECT_BATTLE_DESTROY_REDIRECT EffectCode = 204
+	// 战斗伤害视为效果伤害
+	EFFECT_BATTLE_DAMAGE_TO_EFFECT EffectCode = 205
+	// 重新抛硬币
+	EFFECT_TOSS_COIN_REPLACE EffectCode = 220
+	// 重新掷骰子
+	EFFECT_TOSS_DICE_REPLACE EffectCode = 221
+	// 指定融合素材的條件
+	EFFECT_FUSION_MATERIAL EffectCode = 230
+	// 玩家受到連鎖物質的效果影響
+	EFFECT_CHAIN_MATERIAL EffectCode = 231
+	// 可以当作同调素材
+	EFFECT_SYNCHRO_MATERIAL EffectCode = 232
+	// 可以当作超量素材
+	EFFECT_XYZ_MATERIAL EffectCode = 233
+	// 代替融合素材
+	EFFECT_FUSION_SUBSTITUTE EffectCode = 234
+	// 不能做融合素材
+	EFFECT_CANNOT_BE_FUSION_MATERIAL EffectCode = 235
+	// 不能做同调素材
+	EFFECT_CANNOT_BE_SYNCHRO_MATERIAL EffectCode = 236
+	// 同调素材限制
+	EFFECT_SYNCHRO_MATERIAL_CUSTOM EffectCode = 237
+	// 不能做超量素材
+	EFFECT_CANNOT_BE_XYZ_MATERIAL EffectCode = 238
+	// 做同调素材时的等级
+	EFFECT_SYNCHRO_LEVEL EffectCode = 240
+	// 做仪式祭品时的等级
+	EFFECT_RITUAL_LEVEL EffectCode = 241
+	// 做超量素材时的等级
+	EFFECT_XYZ_LEVEL EffectCode = 242
+	// 在墓地当做仪式祭品
+	EFFECT_EXTRA_RITUAL_MATERIAL EffectCode = 243
+	// 同时当作调整以外的怪兽（幻影王 幽骑）
+	EFFECT_NONTUNER EffectCode = 244
+	// 代替去除超量素材
+	EFFECT_OVERLAY_REMOVE_REPLACE EffectCode = 245
+	// 废铁奇美拉
+	EFFECT_SCRAP_CHIMERA   EffectCode = 246
+	EFFECT_TUNE_MAGICIAN_X EffectCode = 247
+	// 可存取怪獸的各項數值(Card.AddMonsterAttribute()專用)
+	EFFECT_PRE_MONSTER EffectCode = 250
+	// 检查素材
+	EFFECT_MATERIAL_CHECK EffectCode = 251
+	// 无效区域（扰乱王等）
+	EFFECT_DISABLE_FIELD EffectCode = 260
+	// 怪兽区域封锁
+	EFFECT_USE_EXTRA_MZONE EffectCode = 261
+	// 魔法区域封锁
+	EFFECT_USE_EXTRA_SZONE EffectCode = 262
+	// 怪獸区格數上限
+	EFFECT_MAX_MZONE EffectCode = 263
+	// 魔陷区格數上限
+	EFFECT_MAX_SZONE EffectCode = 264
+	// 手牌数量限制
+	EFFECT_HAND_LIMIT EffectCode = 270
+	// 抽卡阶段的抽卡数
+	EFFECT_DRAW_COUNT EffectCode = 271
+	// 灵魂怪兽不返回手牌
+	EFFECT_SPIRIT_DONOT_RETURN EffectCode = 280
+	// 灵魂怪兽可以不返回手牌
+	EFFECT_SPIRIT_MAYNOT_RETURN EffectCode = 281
+	// 改变场地
+	EFFECT_CHANGE_ENVIRONMENT EffectCode = 290
+	// 王家长眠之谷
+	EFFECT_NECRO_VALLEY EffectCode = 291
+	// 不能Play(禁止令)
+	EFFECT_FORBIDDEN EffectCode = 292
+	// 不受「王家长眠之谷」的影响
+	EFFECT_NECRO_VALLEY_IM EffectCode = 293
+	// 翻转卡组
+	EFFECT_REVERSE_DECK EffectCode = 294
+	// 洗脑解除
+	EFFECT_REMOVE_BRAINWASHING EffectCode = 295
+	// 2次战斗阶段
+	EFFECT_BP_TWICE EffectCode = 296
+	// 場上只能存在1張(Card.SetUniqueOnField()專用)
+	EFFECT_UNIQUE_CHECK EffectCode = 297
+	// Match胜利(胜利龙)
+	EFFECT_MATCH_KILL EffectCode = 300
+	// 基因组斗士
+	EFFECT_SYNCHRO_CHECK EffectCode = 310
+	// 对方回合从自己手卡发动（失乐的圣女）
+	EFFECT_QP_ACT_IN_NTPHAND EffectCode = 311
+	// 必须作为同调素材（波动龙 声子龙）
+	EFFECT_MUST_BE_SMATERIAL EffectCode = 312
+	// 重新指定去向(寶玉獸)
+	EFFECT_TO_GRAVE_REDIRECT_CB EffectCode = 313
+	// 設定最終等級(銀河女王之光)
+	EFFECT_CHANGE_LEVEL_FINAL EffectCode = 314
+	// 設定最終階級
+	EFFECT_CHANGE_RANK_FINAL EffectCode = 315
+	// P召唤规则
+	EFFECT_SPSUMMON_PROC_G EffectCode = 320
+	// 特殊召唤次数限制
+	EFFECT_SPSUMMON_COUNT_LIMIT EffectCode = 330
+	// 剩餘召喚次數(召喚限制網)
+	EFFECT_LEFT_SPSUMMON_COUNT EffectCode = 331
+	// 對手不能選擇為攻擊對象
+	EFFECT_CANNOT_SELECT_BATTLE_TARGET EffectCode = 332
+	// 對手不能選擇為效果對象
+	EFFECT_CANNOT_SELECT_EFFECT_TARGET EffectCode = 333
+	// 视为「XX」字段的效果
+	EFFECT_ADD_SETCODE EffectCode = 334
+	// 玩家已受到"效果傷害變成0"的效果影響
+	EFFECT_NO_EFFECT_DAMAGE EffectCode = 335
+	// 不能通常召唤的怪獸
+	EFFECT_UNSUMMONABLE_CARD EffectCode = 336
+	// N/A
+	EFFECT_DISABLE_CHAIN_FIELD EffectCode = 337
+	// 反制陷阱捨棄手牌的代價改變(解放之阿里阿德涅)
+	EFFECT_DISCARD_COST_CHANGE EffectCode = 338
+	// 用手牌的怪獸當作同步素材
+	EFFECT_HAND_SYNCHRO EffectCode = 339
+	// 作为融合素材时可以当作某一卡名(融合识别)
+	EFFECT_ADD_FUSION_CODE EffectCode = 340
+	// 作为融合素材时可以当作某一字段(魔玩具改造)
+	EFFECT_ADD_FUSION_SETCODE EffectCode = 341
+	// 仁王立
+	EFFECT_RISE_TO_FULL_HEIGHT EffectCode = 342
+	// 只能攻擊X
+	EFFECT_ONLY_ATTACK_MONSTER EffectCode = 343
+	// 若攻擊則必須攻擊X
+	EFFECT_MUST_ATTACK_MONSTER EffectCode = 344
+	// 由對手選擇攻擊對象(黑暗貴族)
+	EFFECT_PATRICIAN_OF_DARKNESS EffectCode = 345
+	// 對怪獸攻擊X次
+	EFFECT_EXTRA_ATTACK_MONSTER EffectCode = 346
+	// 同盟状态
+	EFFECT_UNION_STATUS EffectCode = 347
+	// 旧同盟状态
+	EFFECT_OLDUNION_STATUS EffectCode = 348
+	// reserve
+	EFFECT_ADD_FUSION_ATTRIBUTE EffectCode = 349
+	// reserve
+	EFFECT_REMOVE_FUSION_ATTRIBUTE EffectCode = 350
+	// 用作融合素材时的属性
+	EFFECT_CHANGE_FUSION_ATTRIBUTE EffectCode = 351
+	EFFECT_EXTRA_FUSION_MATERIAL   EffectCode = 352
+)
+
+var effectCodeValues = map[string]EffectCode{
+	"immune_effect":               EFFECT_IMMUNE_EFFECT,
+	"disable":                     EFFECT_DISABLE,
+	"cannot_disable":              EFFECT_CANNOT_DISABLE,
+	"set_control":                 EFFECT_SET_CONTROL,
+	"cannot_change_control":       EFFECT_CANNOT_CHANGE_CONTROL,
+	"cannot_activate":             EFFECT_CANNOT_ACTIVATE,
+	"cannot_trigger":              EFFECT_CANNOT_TRIGGER,
+	"disable_effect":              EFFECT_DISABLE_EFFECT,
+	"disable_chain":               EFFECT_DISABLE_CHAIN,
+	"disable_trapmonster":         EFFECT_DISABLE_TRAPMONSTER,
+	"cannot_inactivate":           EFFECT_CANNOT_INACTIVATE,
+	"cannot_diseffect":            EFFECT_CANNOT_DISEFFECT,
+	"cannot_change_position":      EFFECT_CANNOT_CHANGE_POSITION,
+	"trap_act_in_hand":            EFFECT_TRAP_ACT_IN_HAND,
+	"trap_act_in_set_turn":        EFFECT_TRAP_ACT_IN_SET_TURN,
+	"remain_field":                EFFECT_REMAIN_FIELD,
+	"monster_sset":                EFFECT_MONSTER_SSET,
+	"cannot_summon":               EFFECT_CANNOT_SUMMON,
+	"cannot_flip_summon":          EFFECT_CANNOT_FLIP_SUMMON,
+	"cannot_special_summon":       EFFECT_CANNOT_SPECIAL_SUMMON,
+	"cannot_mset":                 EFFECT_CANNOT_MSET,
+	"cannot_sset":                 EFFECT_CANNOT_SSET,
+	"cannot_draw":                 EFFECT_CANNOT_DRAW,
+	"cannot_disable_summon":       EFFECT_CANNOT_DISABLE_SUMMON,
+	"cannot_disable_spsummon":     EFFECT_CANNOT_DISABLE_SPSUMMON,
+	"set_summon_count_limit":      EFFECT_SET_SUMMON_COUNT_LIMIT,
+	"extra_summon_count":          EFFECT_EXTRA_SUMMON_COUNT,
+	"spsummon_condition":          EFFECT_SPSUMMON_CONDITION,
+	"revive_limit":                EFFECT_REVIVE_LIMIT,
+	"summon_proc":                 EFFECT_SUMMON_PROC,
+	"limit_summon_proc":           EFFECT_LIMIT_SUMMON_PROC,
+	"spsummon_proc":               EFFECT_SPSUMMON_PROC,
+	"extra_set_count":             EFFECT_EXTRA_SET_COUNT,
+	"set_proc":                    EFFECT_SET_PROC,
+	"limit_set_proc":              EFFECT_LIMIT_SET_PROC,
+	"devine_light":                EFFECT_DEVINE_LIGHT,
+	"cannot_disable_flip_summon":  EFFECT_CANNOT_DISABLE_FLIP_SUMMON,
+	"indestructable":              EFFECT_INDESTRUCTABLE,
+	"indestructable_effect":       EFFECT_INDESTRUCTABLE_EFFECT,
+	"indestructable_battle":       EFFECT_INDESTRUCTABLE_BATTLE,
+	"unreleasable_sum":            EFFECT_UNRELEASABLE_SUM,
+	"unreleasable_nonsum":         EFFECT_UNRELEASABLE_NONSUM,
+	"destroy_substitute":          EFFECT_DESTROY_SUBSTITUTE,
+	"cannot_release":              EFFECT_CANNOT_RELEASE,
+	"indestructable_count":        EFFECT_INDESTRUCTABLE_COUNT,
+	"unreleasable_effect":         EFFECT_UNRELEASABLE_EFFECT,
+	"destroy_replace":             EFFECT_DESTROY_REPLACE,
+	"release_replace":             EFFECT_RELEASE_REPLACE,
+	"send_replace":                EFFECT_SEND_REPLACE,
+	"cannot_discard_hand":         EFFECT_CANNOT_DISCARD_HAND,
+	"cannot_discard_deck":         EFFECT_CANNOT_DISCARD_DECK,
+	"cannot_use_as_cost":          EFFECT_CANNOT_USE_AS_COST,
+	"cannot_place_counter":        EFFECT_CANNOT_PLACE_COUNTER,
+	"leave_field_redirect":        EFFECT_LEAVE_FIELD_REDIRECT,
+	"to_hand_redirect":            EFFECT_TO_HAND_REDIRECT,
+	"to_deck_redirect":            EFFECT_TO_DECK_REDIRECT,
+	"to_grave_redirect":           EFFECT_TO_GRAVE_REDIRECT,
+	"remove_redirect":             EFFECT_REMOVE_REDIRECT,
+	"cannot_to_hand":              EFFECT_CANNOT_TO_HAND,
+	"cannot_to_deck":              EFFECT_CANNOT_TO_DECK,
+	"cannot_remove":               EFFECT_CANNOT_REMOVE,
+	"cannot_to_grave":             EFFECT_CANNOT_TO_GRAVE,
+	"cannot_turn_set":             EFFECT_CANNOT_TURN_SET,
+	"cannot_be_battle_target":     EFFECT_CANNOT_BE_BATTLE_TARGET,
+	"cannot_be_effect_target":     EFFECT_CANNOT_BE_EFFECT_TARGET,
+	"ignore_battle_target":        EFFECT_IGNORE_BATTLE_TARGET,
+	"cannot_direct_attack":        EFFECT_CANNOT_DIRECT_ATTACK,
+	"direct_attack":               EFFECT_DIRECT_ATTACK,
+	"dual_status":                 EFFECT_DUAL_STATUS,
+	"equip_limit":                 EFFECT_EQUIP_LIMIT,
+	"dual_summonable":             EFFECT_DUAL_SUMMONABLE,
+	"reverse_damage":              EFFECT_REVERSE_DAMAGE,
+	"reverse_recover":             EFFECT_REVERSE_RECOVER,
+	"change_damage":               EFFECT_CHANGE_DAMAGE,
+	"reflect_damage":              EFFECT_REFLECT_DAMAGE,
+	"cannot_attack":               EFFECT_CANNOT_ATTACK,
+	"cannot_attack_announce":      EFFECT_CANNOT_ATTACK_ANNOUNCE,
+	"cannot_change_pos_e":         EFFECT_CANNOT_CHANGE_POS_E,
+	"activate_cost":               EFFECT_ACTIVATE_COST,
+	"summon_cost":                 EFFECT_SUMMON_COST,
+	"spsummon_cost":               EFFECT_SPSUMMON_COST,
+	"flipsummon_cost":             EFFECT_FLIPSUMMON_COST,
+	"mset_cost":                   EFFECT_MSET_COST,
+	"sset_cost":                   EFFECT_SSET_COST,
+	"attack_cost":                 EFFECT_ATTACK_COST,
+	"update_attack":               EFFECT_UPDATE_ATTACK,
+	"set_attack":                  EFFECT_SET_ATTACK,
+	"set_attack_final":            EFFECT_SET_ATTACK_FINAL,
+	"set_base_attack":             EFFECT_SET_BASE_ATTACK,
+	"update_defense":              EFFECT_UPDATE_DEFENSE,
+	"set_defense":                 EFFECT_SET_DEFENSE,
+	"set_defense_final":           EFFECT_SET_DEFENSE_FINAL,
+	"set_base_defense":            EFFECT_SET_BASE_DEFENSE,
+	"reverse_update":              EFFECT_REVERSE_UPDATE,
+	"swap_ad":                     EFFECT_SWAP_AD,
+	"swap_base_ad":                EFFECT_SWAP_BASE_AD,
+	"swap_attack_final":           EFFECT_SWAP_ATTACK_FINAL,
+	"swap_defense_final":          EFFECT_SWAP_DEFENSE_FINAL,
+	"add_code":                    EFFECT_ADD_CODE,
+	"change_code":                 EFFECT_CHANGE_CODE,
+	"add_type":                    EFFECT_ADD_TYPE,
+	"remove_type":                 EFFECT_REMOVE_TYPE,
+	"change_type":                 EFFECT_CHANGE_TYPE,
+	"add_race":                    EFFECT_ADD_RACE,
+	"remove_race":                 EFFECT_REMOVE_RACE,
+	"change_race":                 EFFECT_CHANGE_RACE,
+	"add_attribute":               EFFECT_ADD_ATTRIBUTE,
+	"remove_attribute":            EFFECT_REMOVE_ATTRIBUTE,
+	"change_attribute":            EFFECT_CHANGE_ATTRIBUTE,
+	"update_level":                EFFECT_UPDATE_LEVEL,
+	"change_level":                EFFECT_CHANGE_LEVEL,
+	"update_rank":                 EFFECT_UPDATE_RANK,
+	"change_rank":                 EFFECT_CHANGE_RANK,
+	"update_lscale":               EFFECT_UPDATE_LSCALE,
+	"change_lscale":               EFFECT_CHANGE_LSCALE,
+	"update_rscale":               EFFECT_UPDATE_RSCALE,
+	"change_rscale":               EFFECT_CHANGE_RSCALE,
+	"set_position":                EFFECT_SET_POSITION,
+	"self_destroy":                EFFECT_SELF_DESTROY,
+	"self_tograve":                EFFECT_SELF_TOGRAVE,
+	"double_tribute":              EFFECT_DOUBLE_TRIBUTE,
+	"decrease_tribute":            EFFECT_DECREASE_TRIBUTE,
+	"decrease_tribute_set":        EFFECT_DECREASE_TRIBUTE_SET,
+	"extra_release":               EFFECT_EXTRA_RELEASE,
+	"tribute_limit":               EFFECT_TRIBUTE_LIMIT,
+	"extra_release_sum":           EFFECT_EXTRA_RELEASE_SUM,
+	"triple_tribute":              EFFECT_TRIPLE_TRIBUTE,
+	"public":                      EFFECT_PUBLIC,
+	"counter_permit":              EFFECT_COUNTER_PERMIT,
+	"counter_limit":               EFFECT_COUNTER_LIMIT,
+	"rcounter_replace":            EFFECT_RCOUNTER_REPLACE,
+	"lpcost_change":               EFFECT_LPCOST_CHANGE,
+	"lpcost_replace":              EFFECT_LPCOST_REPLACE,
+	"skip_dp":                     EFFECT_SKIP_DP,
+	"skip_sp":                     EFFECT_SKIP_SP,
+	"skip_m1":                     EFFECT_SKIP_M1,
+	"skip_bp":                     EFFECT_SKIP_BP,
+	"skip_m2":                     EFFECT_SKIP_M2,
+	"cannot_bp":                   EFFECT_CANNOT_BP,
+	"cannot_m2":                   EFFECT_CANNOT_M2,
+	"cannot_ep":                   EFFECT_CANNOT_EP,
+	"skip_turn":                   EFFECT_SKIP_TURN,
+	"defense_attack":              EFFECT_DEFENSE_ATTACK,
+	"must_attack":                 EFFECT_MUST_ATTACK,
+	"first_attack":                EFFECT_FIRST_ATTACK,
+	"attack_all":                  EFFECT_ATTACK_ALL,
+	"extra_attack":                EFFECT_EXTRA_ATTACK,
+	"must_be_attacked":            EFFECT_MUST_BE_ATTACKED,
+	"only_be_attacked":            EFFECT_ONLY_BE_ATTACKED,
+	"attack_disabled":             EFFECT_ATTACK_DISABLED,
+	"no_battle_damage":            EFFECT_NO_BATTLE_DAMAGE,
+	"avoid_battle_damage":         EFFECT_AVOID_BATTLE_DAMAGE,
+	"reflect_battle_damage":       EFFECT_REFLECT_BATTLE_DAMAGE,
+	"pierce":                      EFFECT_PIERCE,
+	"battle_destroy_redirect":     EFFECT_BATTLE_DESTROY_REDIRECT,
+	"battle_damage_to_effect":     EFFECT_BATTLE_DAMAGE_TO_EFFECT,
+	"toss_coin_replace":           EFFECT_TOSS_COIN_REPLACE,
+	"toss_dice_replace":           EFFECT_TOSS_DICE_REPLACE,
+	"fusion_material":             EFFECT_FUSION_MATERIAL,
+	"chain_material":              EFFECT_CHAIN_MATERIAL,
+	"synchro_material":            EFFECT_SYNCHRO_MATERIAL,
+	"xyz_material":                EFFECT_XYZ_MATERIAL,
+	"fusion_substitute":           EFFECT_FUSION_SUBSTITUTE,
+	"cannot_be_fusion_material":   EFFECT_CANNOT_BE_FUSION_MATERIAL,
+	"cannot_be_synchro_material":  EFFECT_CANNOT_BE_SYNCHRO_MATERIAL,
+	"synchro_material_custom":     EFFECT_SYNCHRO_MATERIAL_CUSTOM,
+	"cannot_be_xyz_material":      EFFECT_CANNOT_BE_XYZ_MATERIAL,
+	"synchro_level":               EFFECT_SYNCHRO_LEVEL,
+	"ritual_level":                EFFECT_RITUAL_LEVEL,
+	"xyz_level":                   EFFECT_XYZ_LEVEL,
+	"extra_ritual_material":       EFFECT_EXTRA_RITUAL_MATERIAL,
+	"nontuner":                    EFFECT_NONTUNER,
+	"overlay_remove_replace":      EFFECT_OVERLAY_REMOVE_REPLACE,
+	"scrap_chimera":               EFFECT_SCRAP_CHIMERA,
+	"tune_magician_x":             EFFECT_TUNE_MAGICIAN_X,
+	"pre_monster":                 EFFECT_PRE_MONSTER,
+	"material_check":              EFFECT_MATERIAL_CHECK,
+	"disable_field":               EFFECT_DISABLE_FIELD,
+	"use_extra_mzone":             EFFECT_USE_EXTRA_MZONE,
+	"use_extra_szone":             EFFECT_USE_EXTRA_SZONE,
+	"max_mzone":                   EFFECT_MAX_MZONE,
+	"max_szone":                   EFFECT_MAX_SZONE,
+	"hand_limit":                  EFFECT_HAND_LIMIT,
+	"draw_count":                  EFFECT_DRAW_COUNT,
+	"spirit_donot_return":         EFFECT_SPIRIT_DONOT_RETURN,
+	"spirit_maynot_return":        EFFECT_SPIRIT_MAYNOT_RETURN,
+	"change_environment":          EFFECT_CHANGE_ENVIRONMENT,
+	"necro_valley":                EFFECT_NECRO_VALLEY,
+	"forbidden":                   EFFECT_FORBIDDEN,
+	"necro_valley_im":             EFFECT_NECRO_VALLEY_IM,
+	"reverse_deck":                EFFECT_REVERSE_DECK,
+	"remove_brainwashing":         EFFECT_REMOVE_BRAINWASHING,
+	"bp_twice":                    EFFECT_BP_TWICE,
+	"unique_check":                EFFECT_UNIQUE_CHECK,
+	"match_kill":                  EFFECT_MATCH_KILL,
+	"synchro_check":               EFFECT_SYNCHRO_CHECK,
+	"qp_act_in_ntphand":           EFFECT_QP_ACT_IN_NTPHAND,
+	"must_be_smaterial":           EFFECT_MUST_BE_SMATERIAL,
+	"to_grave_redirect_cb":        EFFECT_TO_GRAVE_REDIRECT_CB,
+	"change_level_final":          EFFECT_CHANGE_LEVEL_FINAL,
+	"change_rank_final":           EFFECT_CHANGE_RANK_FINAL,
+	"spsummon_proc_g":             EFFECT_SPSUMMON_PROC_G,
+	"spsummon_count_limit":        EFFECT_SPSUMMON_COUNT_LIMIT,
+	"left_spsummon_count":         EFFECT_LEFT_SPSUMMON_COUNT,
+	"cannot_select_battle_target": EFFECT_CANNOT_SELECT_BATTLE_TARGET,
+	"cannot_select_effect_target": EFFECT_CANNOT_SELECT_EFFECT_TARGET,
+	"add_setcode":                 EFFECT_ADD_SETCODE,
+	"no_effect_damage":            EFFECT_NO_EFFECT_DAMAGE,
+	"unsummonable_card":           EFFECT_UNSUMMONABLE_CARD,
+	"disable_chain_field":         EFFECT_DISABLE_CHAIN_FIELD,
+	"discard_cost_change":         EFFECT_DISCARD_COST_CHANGE,
+	"hand_synchro":                EFFECT_HAND_SYNCHRO,
+	"add_fusion_code":             EFFECT_ADD_FUSION_CODE,
+	"add_fusion_setcode":          EFFECT_ADD_FUSION_SETCODE,
+	"rise_to_full_height":         EFFECT_RISE_TO_FULL_HEIGHT,
+	"only_attack_monster":         EFFECT_ONLY_ATTACK_MONSTER,
+	"must_attack_monster":         EFFECT_MUST_ATTACK_MONSTER,
+	"patrician_of_darkness":       EFFECT_PATRICIAN_OF_DARKNESS,
+	"extra_attack_monster":        EFFECT_EXTRA_ATTACK_MONSTER,
+	"union_status":                EFFECT_UNION_STATUS,
+	"oldunion_status":             EFFECT_OLDUNION_STATUS,
+	"add_fusion_attribute":        EFFECT_ADD_FUSION_ATTRIBUTE,
+	"remove_fusion_attribute":     EFFECT_REMOVE_FUSION_ATTRIBUTE,
+	"change_fusion_attribute":     EFFECT_CHANGE_FUSION_ATTRIBUTE,
+	"extra_fusion_material":       EFFECT_EXTRA_FUSION_MATERIAL,
+}
+
+var effectCodeNames = []constantName{
+	{1, "EFFECT_IMMUNE_EFFECT"},
+	{2, "EFFECT_DISABLE"},
+	{3, "EFFECT_CANNOT_DISABLE"},
+	{4, "EFFECT_SET_CONTROL"},
+	{5, "EFFECT_CANNOT_CHANGE_CONTROL"},
+	{6, "EFFECT_CANNOT_ACTIVATE"},
+	{7, "EFFECT_CANNOT_TRIGGER"},
+	{8, "EFFECT_DISABLE_EFFECT"},
+	{9, "EFFECT_DISABLE_CHAIN"},
+	{10, "EFFECT_DISABLE_TRAPMONSTER"},
+	{12, "EFFECT_CANNOT_INACTIVATE"},
+	{13, "EFFECT_CANNOT_DISEFFECT"},
+	{14, "EFFECT_CANNOT_CHANGE_POSITION"},
+	{15, "EFFECT_TRAP_ACT_IN_HAND"},
+	{16, "EFFECT_TRAP_ACT_IN_SET_TURN"},
+	{17, "EFFECT_REMAIN_FIELD"},
+	{18, "EFFECT_MONSTER_SSET"},
+	{20, "EFFECT_CANNOT_SUMMON"},
+	{21, "EFFECT_CANNOT_FLIP_SUMMON"},
+	{22, "EFFECT_CANNOT_SPECIAL_SUMMON"},
+	{23, "EFFECT_CANNOT_MSET"},
+	{24, "EFFECT_CANNOT_SSET"},
+	{25, "EFFECT_CANNOT_DRAW"},
+	{26, "EFFECT_CANNOT_DISABLE_SUMMON"},
+	{27, "EFFECT_CANNOT_DISABLE_SPSUMMON"},
+	{28, "EFFECT_SET_SUMMON_COUNT_LIMIT"},
+	{29, "EFFECT_EXTRA_SUMMON_COUNT"},
+	{30, "EFFECT_SPSUMMON_CONDITION"},
+	{31, "EFFECT_REVIVE_LIMIT"},
+	{32, "EFFECT_SUMMON_PROC"},
+	{33, "EFFECT_LIMIT_SUMMON_PROC"},
+	{34, "EFFECT_SPSUMMON_PROC"},
+	{35, "EFFECT_EXTRA_SET_COUNT"},
+	{36, "EFFECT_SET_PROC"},
+	{37, "EFFECT_LIMIT_SET_PROC"},
+	{38, "EFFECT_DEVINE_LIGHT"},
+	{39, "EFFECT_CANNOT_DISABLE_FLIP_SUMMON"},
+	{40, "EFFECT_INDESTRUCTABLE"},
+	{41, "EFFECT_INDESTRUCTABLE_EFFECT"},
+	{42, "EFFECT_INDESTRUCTABLE_BATTLE"},
+	{43, "EFFECT_UNRELEASABLE_SUM"},
+	{44, "EFFECT_UNRELEASABLE_NONSUM"},
+	{45, "EFFECT_DESTROY_SUBSTITUTE"},
+	{46, "EFFECT_CANNOT_RELEASE"},
+	{47, "EFFECT_INDESTRUCTABLE_COUNT"},
+	{48, "EFFECT_UNRELEASABLE_EFFECT"},
+	{50, "EFFECT_DESTROY_REPLACE"},
+	{51, "EFFECT_RELEASE_REPLACE"},
+	{52, "EFFECT_SEND_REPLACE"},
+	{55, "EFFECT_CANNOT_DISCARD_HAND"},
+	{56, "EFFECT_CANNOT_DISCARD_DECK"},
+	{57, "EFFECT_CANNOT_USE_AS_COST"},
+	{58, "EFFECT_CANNOT_PLACE_COUNTER"},
+	{60, "EFFECT_LEAVE_FIELD_REDIRECT"},
+	{61, "EFFECT_TO_HAND_REDIRECT"},
+	{62, "EFFECT_TO_DECK_REDIRECT"},
+	{63, "EFFECT_TO_GRAVE_REDIRECT"},
+	{64, "EFFECT_REMOVE_REDIRECT"},
+	{65, "EFFECT_CANNOT_TO_HAND"},
+	{66, "EFFECT_CANNOT_TO_DECK"},
+	{67, "EFFECT_CANNOT_REMOVE"},
+	{68, "EFFECT_CANNOT_TO_GRAVE"},
+	{69, "EFFECT_CANNOT_TURN_SET"},
+	{70, "EFFECT_CANNOT_BE_BATTLE_TARGET"},
+	{71, "EFFECT_CANNOT_BE_EFFECT_TARGET"},
+	{72, "EFFECT_IGNORE_BATTLE_TARGET"},
+	{73, "EFFECT_CANNOT_DIRECT_ATTACK"},
+	{74, "EFFECT_DIRECT_ATTACK"},
+	{75, "EFFECT_DUAL_STATUS"},
+	{76, "EFFECT_EQUIP_LIMIT"},
+	{77, "EFFECT_DUAL_SUMMONABLE"},
+	{80, "EFFECT_REVERSE_DAMAGE"},
+	{81, "EFFECT_REVERSE_RECOVER"},
+	{82, "EFFECT_CHANGE_DAMAGE"},
+	{83, "EFFECT_REFLECT_DAMAGE"},
+	{85, "EFFECT_CANNOT_ATTACK"},
+	{86, "EFFECT_CANNOT_ATTACK_ANNOUNCE"},
+	{87, "EFFECT_CANNOT_CHANGE_POS_E"},
+	{90, "EFFECT_ACTIVATE_COST"},
+	{91, "EFFECT_SUMMON_COST"},
+	{92, "EFFECT_SPSUMMON_COST"},
+	{93, "EFFECT_FLIPSUMMON_COST"},
+	{94, "EFFECT_MSET_COST"},
+	{95, "EFFECT_SSET_COST"},
+	{96, "EFFECT_ATTACK_COST"},
+	{100, "EFFECT_UPDATE_ATTACK"},
+	{101, "EFFECT_SET_ATTACK"},
+	{102, "EFFECT_SET_ATTACK_FINAL"},
+	{103, "EFFECT_SET_BASE_ATTACK"},
+	{104, "EFFECT_UPDATE_DEFENSE"},
+	{105, "EFFECT_SET_DEFENSE"},
+	{106, "EFFECT_SET_DEFENSE_FINAL"},
+	{107, "EFFECT_SET_BASE_DEFENSE"},
+	{108, "EFFECT_REVERSE_UPDATE"},
+	{109, "EFFECT_SWAP_AD"},
+	{110, "EFFECT_SWAP_BASE_AD"},
+	{111, "EFFECT_SWAP_ATTACK_FINAL"},
+	{112, "EFFECT_SWAP_DEFENSE_FINAL"},
+	{113, "EFFECT_ADD_CODE"},
+	{114, "EFFECT_CHANGE_CODE"},
+	{115, "EFFECT_ADD_TYPE"},
+	{116, "EFFECT_REMOVE_TYPE"},
+	{117, "EFFECT_CHANGE_TYPE"},
+	{120, "EFFECT_ADD_RACE"},
+	{121, "EFFECT_REMOVE_RACE"},
+	{122, "EFFECT_CHANGE_RACE"},
+	{125, "EFFECT_ADD_ATTRIBUTE"},
+	{126, "EFFECT_REMOVE_ATTRIBUTE"},
+	{127, "EFFECT_CHANGE_ATTRIBUTE"},
+	{130, "EFFECT_UPDATE_LEVEL"},
+	{131, "EFFECT_CHANGE_LEVEL"},
+	{132, "EFFECT_UPDATE_RANK"},
+	{133, "EFFECT_CHANGE_RANK"},
+	{134, "EFFECT_UPDATE_LSCALE"},
+	{135, "EFFECT_CHANGE_LSCALE"},
+	{136, "EFFECT_UPDATE_RSCALE"},
+	{137, "EFFECT_CHANGE_RSCALE"},
+	{140, "EFFECT_SET_POSITION"},
+	{141, "EFFECT_SELF_DESTROY"},
+	{142, "EFFECT_SELF_TOGRAVE"},
+	{150, "EFFECT_DOUBLE_TRIBUTE"},
+	{151, "EFFECT_DECREASE_TRIBUTE"},
+	{152, "EFFECT_DECREASE_TRIBUTE_SET"},
+	{153, "EFFECT_EXTRA_RELEASE"},
+	{154, "EFFECT_TRIBUTE_LIMIT"},
+	{155, "EFFECT_EXTRA_RELEASE_SUM"},
+	{156, "EFFECT_TRIPLE_TRIBUTE"},
+	{160, "EFFECT_PUBLIC"},
+	{0x10000, "EFFECT_COUNTER_PERMIT"},
+	{0x20000, "EFFECT_COUNTER_LIMIT"},
+	{0x30000, "EFFECT_RCOUNTER_REPLACE"},
+	{170, "EFFECT_LPCOST_CHANGE"},
+	{171, "EFFECT_LPCOST_REPLACE"},
+	{180, "EFFECT_SKIP_DP"},
+	{181, "EFFECT_SKIP_SP"},
+	{182, "EFFECT_SKIP_M1"},
+	{183, "EFFECT_SKIP_BP"},
+	{184, "EFFECT_SKIP_M2"},
+	{185, "EFFECT_CANNOT_BP"},
+	{186, "EFFECT_CANNOT_M2"},
+	{187, "EFFECT_CANNOT_EP"},
+	{188, "EFFECT_SKIP_TURN"},
+	{190, "EFFECT_DEFENSE_ATTACK"},
+	{191, "EFFECT_MUST_ATTACK"},
+	{192, "EFFECT_FIRST_ATTACK"},
+	{193, "EFFECT_ATTACK_ALL"},
+	{194, "EFFECT_EXTRA_ATTACK"},
+	{195, "EFFECT_MUST_BE_ATTACKED"},
+	{196, "EFFECT_ONLY_BE_ATTACKED"},
+	{197, "EFFECT_ATTACK_DISABLED"},
+	{200, "EFFECT_NO_BATTLE_DAMAGE"},
+	{201, "EFFECT_AVOID_BATTLE_DAMAGE"},
+	{202, "EFFECT_REFLECT_BATTLE_DAMAGE"},
+	{203, "EFFECT_PIERCE"},
+	{204, "EFFECT_BATTLE_DESTROY_REDIRECT"},
+	{205, "EFFECT_BATTLE_DAMAGE_TO_EFFECT"},
+	{220, "EFFECT_TOSS_COIN_REPLACE"},
+	{221, "EFFECT_TOSS_DICE_REPLACE"},
+	{230, "EFFECT_FUSION_MATERIAL"},
+	{231, "EFFECT_CHAIN_MATERIAL"},
+	{232, "EFFECT_SYNCHRO_MATERIAL"},
+	{233, "EFFECT_XYZ_MATERIAL"},
+	{234, "EFFECT_FUSION_SUBSTITUTE"},
+	{235, "EFFECT_CANNOT_BE_FUSION_MATERIAL"},
+	{236, "EFFECT_CANNOT_BE_SYNCHRO_MATERIAL"},
+	{237, "EFFECT_SYNCHRO_MATERIAL_CUSTOM"},
+	{238, "EFFECT_CANNOT_BE_XYZ_MATERIAL"},
+	{240, "EFFECT_SYNCHRO_LEVEL"},
+	{241, "EFFECT_RITUAL_LEVEL"},
+	{242, "EFFECT_XYZ_LEVEL"},
+	{243, "EFFECT_EXTRA_RITUAL_MATERIAL"},
+	{244, "EFFECT_NONTUNER"},
+	{245, "EFFECT_OVERLAY_REMOVE_REPLACE"},
+	{246, "EFFECT_SCRAP_CHIMERA"},
+	{247, "EFFECT_TUNE_MAGICIAN_X"},
+	{250, "EFFECT_PRE_MONSTER"},
+	{251, "EFFECT_MATERIAL_CHECK"},
+	{260, "EFFECT_DISABLE_FIELD"},
+	{261, "EFFECT_USE_EXTRA_MZONE"},
+	{262, "EFFECT_USE_EXTRA_SZONE"},
+	{263, "EFFECT_MAX_MZONE"},
+	{264, "EFFECT_MAX_SZONE"},
+	{270, "EFFECT_HAND_LIMIT"},
+	{271, "EFFECT_DRAW_COUNT"},
+	{280, "EFFECT_SPIRIT_DONOT_RETURN"},
+	{281, "EFFECT_SPIRIT_MAYNOT_RETURN"},
+	{290, "EFFECT_CHANGE_ENVIRONMENT"},
+	{291, "EFFECT_NECRO_VALLEY"},
+	{292, "EFFECT_FORBIDDEN"},
+	{293, "EFFECT_NECRO_VALLEY_IM"},
+	{294, "EFFECT_REVERSE_DECK"},
+	{295, "EFFECT_REMOVE_BRAINWASHING"},
+	{296, "EFFECT_BP_TWICE"},
+	{297, "EFFECT_UNIQUE_CHECK"},
+	{300, "EFFECT_MATCH_KILL"},
+	{310, "EFFECT_SYNCHRO_CHECK"},
+	{311, "EFFECT_QP_ACT_IN_NTPHAND"},
+	{312, "EFFECT_MUST_BE_SMATERIAL"},
+	{313, "EFFECT_TO_GRAVE_REDIRECT_CB"},
+	{314, "EFFECT_CHANGE_LEVEL_FINAL"},
+	{315, "EFFECT_CHANGE_RANK_FINAL"},
+	{320, "EFFECT_SPSUMMON_PROC_G"},
+	{330, "EFFECT_SPSUMMON_COUNT_LIMIT"},
+	{331, "EFFECT_LEFT_SPSUMMON_COUNT"},
+	{332, "EFFECT_CANNOT_SELECT_BATTLE_TARGET"},
+	{333, "EFFECT_CANNOT_SELECT_EFFECT_TARGET"},
+	{334, "EFFECT_ADD_SETCODE"},
+	{335, "EFFECT_NO_EFFECT_DAMAGE"},
+	{336, "EFFECT_UNSUMMONABLE_CARD"},
+	{337, "EFFECT_DISABLE_CHAIN_FIELD"},
+	{338, "EFFECT_DISCARD_COST_CHANGE"},
+	{339, "EFFECT_HAND_SYNCHRO"},
+	{340, "EFFECT_ADD_FUSION_CODE"},
+	{341, "EFFECT_ADD_FUSION_SETCODE"},
+	{342, "EFFECT_RISE_TO_FULL_HEIGHT"},
+	{343, "EFFECT_ONLY_ATTACK_MONSTER"},
+	{344, "EFFECT_MUST_ATTACK_MONSTER"},
+	{345, "EFFECT_PATRICIAN_OF_DARKNESS"},
+	{346, "EFFECT_EXTRA_ATTACK_MONSTER"},
+	{347, "EFFECT_UNION_STATUS"},
+	{348, "EFFECT_OLDUNION_STATUS"},
+	{349, "EFFECT_ADD_FUSION_ATTRIBUTE"},
+	{350, "EFFECT_REMOVE_FUSION_ATTRIBUTE"},
+	{351, "EFFECT_CHANGE_FUSION_ATTRIBUTE"},
+	{352, "EFFECT_EXTRA_FUSION_MATERIAL"},
+}
+
+func (value EffectCode) String() string {
+	return constantString(int64(value), effectCodeNames, "EffectCode")
+}
+
+// Event 诱发效果的事件、时点
+type Event int64
+
+const (
+	// 游戏开始时
+	EVENT_STARTUP Event = 1000
+	// 翻转时
+	EVENT_FLIP Event = 1001
+	// 自由时点（强脱等，还有昴星团等诱发即时效果）
+	EVENT_FREE_CHAIN Event = 1002
+	// 確定被破壞的卡片移動前
+	EVENT_DESTROY Event = 1010
+	// 除外时
+	EVENT_REMOVE Event = 1011
+	// 加入手牌时
+	EVENT_TO_HAND Event = 1012
+	// 回卡组时
+	EVENT_TO_DECK Event = 1013
+	// 送去墓地时(不含REASON_RETURN)
+	EVENT_TO_GRAVE Event = 1014
+	// 离场时
+	EVENT_LEAVE_FIELD Event = 1015
+	// 表示形式变更时
+	EVENT_CHANGE_POS Event = 1016
+	// 解放时
+	EVENT_RELEASE Event = 1017
+	// 丢弃手牌时
+	EVENT_DISCARD Event = 1018
+	// 永久离场时
+	EVENT_LEAVE_FIELD_P Event = 1019
+	// 连锁处理开始时（EVENT_CHAIN_ACTIVATING之後）
+	EVENT_CHAIN_SOLVING Event = 1020
+	// 连锁处理准备中
+	EVENT_CHAIN_ACTIVATING Event = 1021
+	// 连锁处理结束时
+	EVENT_CHAIN_SOLVED Event = 1022
+	// N/A
+	EVENT_CHAIN_ACTIVATED Event = 1023
+	// 连锁发动无效时（EVENT_CHAIN_ACTIVATING之後）
+	EVENT_CHAIN_NEGATED Event = 1024
+	// 连锁效果无效时
+	EVENT_CHAIN_DISABLED Event = 1025
+	// 连锁串结束时
+	EVENT_CHAIN_END Event = 1026
+	// 效果发动时
+	EVENT_CHAINING Event = 1027
+	// 成为效果对象时
+	EVENT_BECOME_TARGET Event = 1028
+	// 被破坏时
+	EVENT_DESTROYED Event = 1029
+	// adjust_all()调整後（御前试合）
+	EVENT_ADJUST Event = 1040
+	// 通常召唤成功时
+	EVENT_SUMMON_SUCCESS Event = 1100
+	// 翻转召唤成功时
+	EVENT_FLIP_SUMMON_SUCCESS Event = 1101
+	// 特殊召唤成功时
+	EVENT_SPSUMMON_SUCCESS Event = 1102
+	// 召唤之际（怪兽还没上场、神宣等时点）
+	EVENT_SUMMON Event = 1103
+	// 翻转召唤之际
+	EVENT_FLIP_SUMMON Event = 1104
+	// 特殊召唤之际
+	EVENT_SPSUMMON Event = 1105
+	// 放置怪兽时
+	EVENT_MSET Event = 1106
+	// 放置魔陷时
+	EVENT_SSET Event = 1107
+	// 作为融合/仪式同调/超量素材时
+	EVENT_BE_MATERIAL Event = 1108
+	// 将要作为融合/仪式同调/超量素材时
+	EVENT_BE_PRE_MATERIAL Event = 1109
+	// 抽卡时
+	EVENT_DRAW Event = 1110
+	// 造成战斗/效果伤害时
+	EVENT_DAMAGE Event = 1111
+	// 回复生命值时
+	EVENT_RECOVER Event = 1112
+	// 抽卡阶段通常抽卡前
+	EVENT_PREDRAW Event = 1113
+	// 控制权变更
+	EVENT_CONTROL_CHANGED Event = 1120
+	// 装备卡装备时
+	EVENT_EQUIP Event = 1121
+	// 攻击宣言时
+	EVENT_ATTACK_ANNOUNCE Event = 1130
+	// 被选为攻击对象时
+	EVENT_BE_BATTLE_TARGET Event = 1131
+	// 伤害步骤开始时（反转前）
+	EVENT_BATTLE_START Event = 1132
+	// 伤害计算前（反转後）
+	EVENT_BATTLE_CONFIRM Event = 1133
+	// 伤害计算时（羽斬）
+	EVENT_PRE_DAMAGE_CALCULATE Event = 1134
+	// N/A
+	EVENT_DAMAGE_CALCULATING Event = 1135
+	// 即将产生战斗伤害(只能使用EFFECT_TYPE_CONTINUOUS)
+	EVENT_PRE_BATTLE_DAMAGE Event = 1136
+	// N/A
+	EVENT_BATTLE_END Event = 1137
+	// 伤害计算后（异女、同反转效果时点）
+	EVENT_BATTLED Event = 1138
+	// 以战斗破坏怪兽送去墓地时（BF-苍炎之修罗）
+	EVENT_BATTLE_DESTROYING Event = 1139
+	// 被战斗破坏送去墓地时（杀人番茄等）
+	EVENT_BATTLE_DESTROYED Event = 1140
+	// 伤害步骤结束时
+	EVENT_DAMAGE_STEP_END Event = 1141
+	// 攻击无效时（翻倍机会）
+	EVENT_ATTACK_DISABLED Event = 1142
+	// 造成战斗伤害时
+	EVENT_BATTLE_DAMAGE Event = 1143
+	// 掷骰子的结果产生后
+	EVENT_TOSS_DICE Event = 1150
+	// 抛硬币的结果产生后
+	EVENT_TOSS_COIN Event = 1151
+	// 重新抛硬币
+	EVENT_TOSS_COIN_NEGATE Event = 1152
+	// 重新掷骰子
+	EVENT_TOSS_DICE_NEGATE Event = 1153
+	// 等级上升时
+	EVENT_LEVEL_UP Event = 1200
+	// 支付生命值时
+	EVENT_PAY_LPCOST Event = 1201
+	// 去除超量素材时
+	EVENT_DETACH_MATERIAL Event = 1202
+	// 回到墓地时
+	EVENT_RETURN_TO_GRAVE Event = 1203
+	// 回合结束时
+	EVENT_TURN_END Event = 1210
+	// 阶段结束时
+	EVENT_PHASE Event = 0x1000
+	// 阶段开始时
+	EVENT_PHASE_START Event = 0x2000
+	// 增加指示物时
+	EVENT_ADD_COUNTER Event = 0x10000
+	// 去除指示物时(A指示物)，Card.RemoveCounter()必須手動觸發此事件
+	EVENT_REMOVE_COUNTER Event = 0x20000
+	// 自訂事件
+	EVENT_CUSTOM Event = 0x10000000
+)
+
+var eventValues = map[string]Event{
+	"startup":              EVENT_STARTUP,
+	"flip":                 EVENT_FLIP,
+	"free_chain":           EVENT_FREE_CHAIN,
+	"destroy":              EVENT_DESTROY,
+	"remove":               EVENT_REMOVE,
+	"to_hand":              EVENT_TO_HAND,
+	"to_deck":              EVENT_TO_DECK,
+	"to_grave":             EVENT_TO_GRAVE,
+	"leave_field":          EVENT_LEAVE_FIELD,
+	"change_pos":           EVENT_CHANGE_POS,
+	"release":              EVENT_RELEASE,
+	"discard":              EVENT_DISCARD,
+	"leave_field_p":        EVENT_LEAVE_FIELD_P,
+	"chain_solving":        EVENT_CHAIN_SOLVING,
+	"chain_activating":     EVENT_CHAIN_ACTIVATING,
+	"chain_solved":         EVENT_CHAIN_SOLVED,
+	"chain_activated":      EVENT_CHAIN_ACTIVATED,
+	"chain_negated":        EVENT_CHAIN_NEGATED,
+	"chain_disabled":       EVENT_CHAIN_DISABLED,
+	"chain_end":            EVENT_CHAIN_END,
+	"chaining":             EVENT_CHAINING,
+	"become_target":        EVENT_BECOME_TARGET,
+	"destroyed":            EVENT_DESTROYED,
+	"adjust":               EVENT_ADJUST,
+	"summon_success":       EVENT_SUMMON_SUCCESS,
+	"flip_summon_success":  EVENT_FLIP_SUMMON_SUCCESS,
+	"spsummon_success":     EVENT_SPSUMMON_SUCCESS,
+	"summon":               EVENT_SUMMON,
+	"flip_summon":          EVENT_FLIP_SUMMON,
+	"spsummon":             EVENT_SPSUMMON,
+	"mset":                 EVENT_MSET,
+	"sset":                 EVENT_SSET,
+	"be_material":          EVENT_BE_MATERIAL,
+	"be_pre_material":      EVENT_BE_PRE_MATERIAL,
+	"draw":                 EVENT_DRAW,
+	"damage":               EVENT_DAMAGE,
+	"recover":              EVENT_RECOVER,
+	"predraw":              EVENT_PREDRAW,
+	"control_changed":      EVENT_CONTROL_CHANGED,
+	"equip":                EVENT_EQUIP,
+	"attack_announce":      EVENT_ATTACK_ANNOUNCE,
+	"be_battle_target":     EVENT_BE_BATTLE_TARGET,
+	"battle_start":         EVENT_BATTLE_START,
+	"battle_confirm":       EVENT_BATTLE_CONFIRM,
+	"pre_damage_calculate": EVENT_PRE_DAMAGE_CALCULATE,
+	"damage_calculating":   EVENT_DAMAGE_CALCULATING,
+	"pre_battle_damage":    EVENT_PRE_BATTLE_DAMAGE,
+	"battle_end":           EVENT_BATTLE_END,
+	"battled":              EVENT_BATTLED,
+	"battle_destroying":    EVENT_BATTLE_DESTROYING,
+	"battle_destroyed":     EVENT_BATTLE_DESTROYED,
+	"damage_step_end":      EVENT_DAMAGE_STEP_END,
+	"attack_disabled":      EVENT_ATTACK_DISABLED,
+	"battle_damage":        EVENT_BATTLE_DAMAGE,
+	"toss_dice":            EVENT_TOSS_DICE,
+	"toss_coin":            EVENT_TOSS_COIN,
+	"toss_coin_negate":     EVENT_TOSS_COIN_NEGATE,
+	"toss_dice_negate":     EVENT_TOSS_DICE_NEGATE,
+	"level_up":             EVENT_LEVEL_UP,
+	"pay_lpcost":           EVENT_PAY_LPCOST,
+	"detach_material":      EVENT_DETACH_MATERIAL,
+	"return_to_grave":      EVENT_RETURN_TO_GRAVE,
+	"turn_end":             EVENT_TURN_END,
+	"phase":                EVENT_PHASE,
+	"phase_start":          EVENT_PHASE_START,
+	"add_counter":          EVENT_ADD_COUNTER,
+	"remove_counter":       EVENT_REMOVE_COUNTER,
+	"custom":               EVENT_CUSTOM,
+}
+
+var eventNames = []constantName{
+	{1000, "EVENT_STARTUP"},
+	{1001, "EVENT_FLIP"},
+	{1002, "EVENT_FREE_CHAIN"},
+	{1010, "EVENT_DESTROY"},
+	{1011, "EVENT_REMOVE"},
+	{1012, "EVENT_TO_HAND"},
+	{1013, "EVENT_TO_DECK"},
+	{1014, "EVENT_TO_GRAVE"},
+	{1015, "EVENT_LEAVE_FIELD"},
+	{1016, "EVENT_CHANGE_POS"},
+	{1017, "EVENT_RELEASE"},
+	{1018, "EVENT_DISCARD"},
+	{1019, "EVENT_LEAVE_FIELD_P"},
+	{1020, "EVENT_CHAIN_SOLVING"},
+	{1021, "EVENT_CHAIN_ACTIVATING"},
+	{1022, "EVENT_CHAIN_SOLVED"},
+	{1023, "EVENT_CHAIN_ACTIVATED"},
+	{1024, "EVENT_CHAIN_NEGATED"},
+	{1025, "EVENT_CHAIN_DISABLED"},
+	{1026, "EVENT_CHAIN_END"},
+	{1027, "EVENT_CHAINING"},
+	{1028, "EVENT_BECOME_TARGET"},
+	{1029, "EVENT_DESTROYED"},
+	{1040, "EVENT_ADJUST"},
+	{1100, "EVENT_SUMMON_SUCCESS"},
+	{1101, "EVENT_FLIP_SUMMON_SUCCESS"},
+	{1102, "EVENT_SPSUMMON_SUCCESS"},
+	{1103, "EVENT_SUMMON"},
+	{1104, "EVENT_FLIP_SUMMON"},
+	{1105, "EVENT_SPSUMMON"},
+	{1106, "EVENT_MSET"},
+	{1107, "EVENT_SSET"},
+	{1108, "EVENT_BE_MATERIAL"},
+	{1109, "EVENT_BE_PRE_MATERIAL"},
+	{1110, "EVENT_DRAW"},
+	{1111, "EVENT_DAMAGE"},
+	{1112, "EVENT_RECOVER"},
+	{1113, "EVENT_PREDRAW"},
+	{1120, "EVENT_CONTROL_CHANGED"},
+	{1121, "EVENT_EQUIP"},
+	{1130, "EVENT_ATTACK_ANNOUNCE"},
+	{1131, "EVENT_BE_BATTLE_TARGET"},
+	{1132, "EVENT_BATTLE_START"},
+	{1133, "EVENT_BATTLE_CONFIRM"},
+	{1134, "EVENT_PRE_DAMAGE_CALCULATE"},
+	{1135, "EVENT_DAMAGE_CALCULATING"},
+	{1136, "EVENT_PRE_BATTLE_DAMAGE"},
+	{1137, "EVENT_BATTLE_END"},
+	{1138, "EVENT_BATTLED"},
+	{1139, "EVENT_BATTLE_DESTROYING"},
+	{1140, "EVENT_BATTLE_DESTROYED"},
+	{1141, "EVENT_DAMAGE_STEP_END"},
+	{1142, "EVENT_ATTACK_DISABLED"},
+	{1143, "EVENT_BATTLE_DAMAGE"},
+	{1150, "EVENT_TOSS_DICE"},
+	{1151, "EVENT_TOSS_COIN"},
+	{1152, "EVENT_TOSS_COIN_NEGATE"},
+	{1153, "EVENT_TOSS_DICE_NEGATE"},
+	{1200, "EVENT_LEVEL_UP"},
+	{1201, "EVENT_PAY_LPCOST"},
+	{1202, "EVENT_DETACH_MATERIAL"},
+	{1203, "EVENT_RETURN_TO_GRAVE"},
+	{1210, "EVENT_TURN_END"},
+	{0x1000, "EVENT_PHASE"},
+	{0x2000, "EVENT_PHASE_START"},
+	{0x10000, "EVENT_ADD_COUNTER"},
+	{0x20000, "EVENT_REMOVE_COUNTER"},
+	{0x10000000, "EVENT_CUSTOM"},
+}
+
+func (value Event) String() string {
+	return constantString(int64(value), eventNames, "Event")
+}
+
+// Category 效果分类
+type Category int64
+
+const (
+	// 破坏效果
+	CATEGORY_DESTROY Category = 0x1
+	// 解放效果
+	CATEGORY_RELEASE Category = 0x2
+	// 除外效果
+	CATEGORY_REMOVE Category = 0x4
+	// 回手牌效果
+	CATEGORY_TOHAND Category = 0x8
+	// 回卡组效果
+	CATEGORY_TODECK Category = 0x10
+	// 送去墓地效果
+	CATEGORY_TOGRAVE Category = 0x20
+	// 從卡组送去墓地效果
+	CATEGORY_DECKDES Category = 0x40
+	// 捨棄手牌效果
+	CATEGORY_HANDES Category = 0x80
+	// 含召唤的效果
+	CATEGORY_SUMMON Category = 0x100
+	// 含特殊召唤的效果
+	CATEGORY_SPECIAL_SUMMON Category = 0x200
+	// 含衍生物效果
+	CATEGORY_TOKEN Category = 0x400
+	// 含翻转效果
+	CATEGORY_FLIP Category = 0x800
+	// 改变表示形式效果
+	CATEGORY_POSITION Category = 0x1000
+	// 改变控制权效果
+	CATEGORY_CONTROL Category = 0x2000
+	// 使效果无效效果
+	CATEGORY_DISABLE Category = 0x4000
+	// 无效召唤效果
+	CATEGORY_DISABLE_SUMMON Category = 0x8000
+	// 抽卡效果
+	CATEGORY_DRAW Category = 0x10000
+	// 检索卡组效果
+	CATEGORY_SEARCH Category = 0x20000
+	// 装备效果
+	CATEGORY_EQUIP Category = 0x40000
+	// 伤害效果
+	CATEGORY_DAMAGE Category = 0x80000
+	// 回复效果
+	CATEGORY_RECOVER Category = 0x100000
+	// 改变攻击效果
+	CATEGORY_ATKCHANGE Category = 0x200000
+	// 改变防御效果
+	CATEGORY_DEFCHANGE Category = 0x400000
+	// 指示物效果
+	CATEGORY_COUNTER Category = 0x800000
+	// 硬币效果
+	CATEGORY_COIN Category = 0x1000000
+	// 骰子效果
+	CATEGORY_DICE Category = 0x2000000
+	// 离开墓地效果
+	CATEGORY_LEAVE_GRAVE Category = 0x4000000
+	// 改变等级效果
+	CATEGORY_LVCHANGE Category = 0x8000000
+	// 使发动无效效果
+	CATEGORY_NEGATE Category = 0x10000000
+	// 發動時宣言卡名的效果
+	CATEGORY_ANNOUNCE      Category = 0x20000000
+	CATEGORY_FUSION_SUMMON Category = 0x40000000
+)
+
+var categoryValues = map[string]Category{
+	"destroy":        CATEGORY_DESTROY,
+	"release":        CATEGORY_RELEASE,
+	"remove":         CATEGORY_REMOVE,
+	"tohand":         CATEGORY_TOHAND,
+	"todeck":         CATEGORY_TODECK,
+	"tograve":        CATEGORY_TOGRAVE,
+	"deckdes":        CATEGORY_DECKDES,
+	"handes":         CATEGORY_HANDES,
+	"summon":         CATEGORY_SUMMON,
+	"special_summon": CATEGORY_SPECIAL_SUMMON,
+	"token":          CATEGORY_TOKEN,
+	"flip":           CATEGORY_FLIP,
+	"position":       CATEGORY_POSITION,
+	"control":        CATEGORY_CONTROL,
+	"disable":        CATEGORY_DISABLE,
+	"disable_summon": CATEGORY_DISABLE_SUMMON,
+	"draw":           CATEGORY_DRAW,
+	"search":         CATEGORY_SEARCH,
+	"equip":          CATEGORY_EQUIP,
+	"damage":         CATEGORY_DAMAGE,
+	"recover":        CATEGORY_RECOVER,
+	"atkchange":      CATEGORY_ATKCHANGE,
+	"defchange":      CATEGORY_DEFCHANGE,
+	"counter":        CATEGORY_COUNTER,
+	"coin":           CATEGORY_COIN,
+	"dice":           CATEGORY_DICE,
+	"leave_grave":    CATEGORY_LEAVE_GRAVE,
+	"lvchange":       CATEGORY_LVCHANGE,
+	"negate":         CATEGORY_NEGATE,
+	"announce":       CATEGORY_ANNOUNCE,
+	"fusion_summon":  CATEGORY_FUSION_SUMMON,
+}
+
+var categoryNames = []constantName{
+	{0x1, "CATEGORY_DESTROY"},
+	{0x2, "CATEGORY_RELEASE"},
+	{0x4, "CATEGORY_REMOVE"},
+	{0x8, "CATEGORY_TOHAND"},
+	{0x10, "CATEGORY_TODECK"},
+	{0x20, "CATEGORY_TOGRAVE"},
+	{0x40, "CATEGORY_DECKDES"},
+	{0x80, "CATEGORY_HANDES"},
+	{0x100, "CATEGORY_SUMMON"},
+	{0x200, "CATEGORY_SPECIAL_SUMMON"},
+	{0x400, "CATEGORY_TOKEN"},
+	{0x800, "CATEGORY_FLIP"},
+	{0x1000, "CATEGORY_POSITION"},
+	{0x2000, "CATEGORY_CONTROL"},
+	{0x4000, "CATEGORY_DISABLE"},
+	{0x8000, "CATEGORY_DISABLE_SUMMON"},
+	{0x10000, "CATEGORY_DRAW"},
+	{0x20000, "CATEGORY_SEARCH"},
+	{0x40000, "CATEGORY_EQUIP"},
+	{0x80000, "CATEGORY_DAMAGE"},
+	{0x100000, "CATEGORY_RECOVER"},
+	{0x200000, "CATEGORY_ATKCHANGE"},
+	{0x400000, "CATEGORY_DEFCHANGE"},
+	{0x800000, "CATEGORY_COUNTER"},
+	{0x1000000, "CATEGORY_COIN"},
+	{0x2000000, "CATEGORY_DICE"},
+	{0x4000000, "CATEGORY_LEAVE_GRAVE"},
+	{0x8000000, "CATEGORY_LVCHANGE"},
+	{0x10000000, "CATEGORY_NEGATE"},
+	{0x20000000, "CATEGORY_ANNOUNCE"},
+	{0x40000000, "CATEGORY_FUSION_SUMMON"},
+}
+
+func (value Category) String() string {
+	return constantString(int64(value), categoryNames, "Category")
+}
+
+// Hint 提示类型
+type Hint int64
+
+const (
+	HINT_EVENT      Hint = 1
+	HINT_MESSAGE    Hint = 2
+	HINT_SELECTMSG  Hint = 3
+	HINT_OPSELECTED Hint = 4
+	HINT_EFFECT     Hint = 5
+	HINT_RACE       Hint = 6
+	HINT_ATTRIB     Hint = 7
+	HINT_CODE       Hint = 8
+	HINT_NUMBER     Hint = 9
+	HINT_CARD       Hint = 10
+)
+
+var hintValues = map[string]Hint{
+	"event":      HINT_EVENT,
+	"message":    HINT_MESSAGE,
+	"selectmsg":  HINT_SELECTMSG,
+	"opselected": HINT_OPSELECTED,
+	"effect":     HINT_EFFECT,
+	"race":       HINT_RACE,
+	"attrib":     HINT_ATTRIB,
+	"code":       HINT_CODE,
+	"number":     HINT_NUMBER,
+	"card":       HINT_CARD,
+}
+
+var hintNames = []constantName{
+	{1, "HINT_EVENT"},
+	{2, "HINT_MESSAGE"},
+	{3, "HINT_SELECTMSG"},
+	{4, "HINT_OPSELECTED"},
+	{5, "HINT_EFFECT"},
+	{6, "HINT_RACE"},
+	{7, "HINT_ATTRIB"},
+	{8, "HINT_CODE"},
+	{9, "HINT_NUMBER"},
+	{10, "HINT_CARD"},
+}
+
+func (value Hint) String() string {
+	return constantString(int64(value), hintNames, "Hint")
+}
+
+// CardHint 卡片提示类型
+type CardHint int64
+
+const (
+	CHINT_TURN      CardHint = 1
+	CHINT_CARD      CardHint = 2
+	CHINT_RACE      CardHint = 3
+	CHINT_ATTRIBUTE CardHint = 4
+	CHINT_NUMBER    CardHint = 5
+	CHINT_DESC      CardHint = 6
+)
+
+var cardHintValues = map[string]CardHint{
+	"turn":      CHINT_TURN,
+	"card":      CHINT_CARD,
+	"race":      CHINT_RACE,
+	"attribute": CHINT_ATTRIBUTE,
+	"number":    CHINT_NUMBER,
+	"desc":      CHINT_DESC,
+}
+
+var cardHintNames = []constantName{
+	{1, "CHINT_TURN"},
+	{2, "CHINT_CARD"},
+	{3, "CHINT_RACE"},
+	{4, "CHINT_ATTRIBUTE"},
+	{5, "CHINT_NUMBER"},
+	{6, "CHINT_DESC"},
+}
+
+func (value CardHint) String() string {
+	return constantString(int64(value), cardHintNames, "CardHint")
+}
+
+// Opcode 操作码
+type Opcode int64
+
+const (
+	OPCODE_ADD         Opcode = 0x40000000
+	OPCODE_SUB         Opcode = 0x40000001
+	OPCODE_MUL         Opcode = 0x40000002
+	OPCODE_DIV         Opcode = 0x40000003
+	OPCODE_AND         Opcode = 0x40000004
+	OPCODE_OR          Opcode = 0x40000005
+	OPCODE_NEG         Opcode = 0x40000006
+	OPCODE_NOT         Opcode = 0x40000007
+	OPCODE_ISCODE      Opcode = 0x40000100
+	OPCODE_ISSETCARD   Opcode = 0x40000101
+	OPCODE_ISTYPE      Opcode = 0x40000102
+	OPCODE_ISRACE      Opcode = 0x40000103
+	OPCODE_ISATTRIBUTE Opcode = 0x40000104
+)
+
+var opcodeValues = map[string]Opcode{
+	"add":         OPCODE_ADD,
+	"sub":         OPCODE_SUB,
+	"mul":         OPCODE_MUL,
+	"div":         OPCODE_DIV,
+	"and":         OPCODE_AND,
+	"or":          OPCODE_OR,
+	"neg":         OPCODE_NEG,
+	"not":         OPCODE_NOT,
+	"iscode":      OPCODE_ISCODE,
+	"issetcard":   OPCODE_ISSETCARD,
+	"istype":      OPCODE_ISTYPE,
+	"israce":      OPCODE_ISRACE,
+	"isattribute": OPCODE_ISATTRIBUTE,
+}
+
+var opcodeNames = []constantName{
+	{0x40000000, "OPCODE_ADD"},
+	{0x40000001, "OPCODE_SUB"},
+	{0x40000002, "OPCODE_MUL"},
+	{0x40000003, "OPCODE_DIV"},
+	{0x40000004, "OPCODE_AND"},
+	{0x40000005, "OPCODE_OR"},
+	{0x40000006, "OPCODE_NEG"},
+	{0x40000007, "OPCODE_NOT"},
+	{0x40000100, "OPCODE_ISCODE"},
+	{0x40000101, "OPCODE_ISSETCARD"},
+	{0x40000102, "OPCODE_ISTYPE"},
+	{0x40000103, "OPCODE_ISRACE"},
+	{0x40000104, "OPCODE_ISATTRIBUTE"},
+}
+
+func (value Opcode) String() string {
+	return constantString(int64(value), opcodeNames, "Opcode")
+}
+
+// HintMessage 提示消息
+type HintMessage int64
+
+const (
+	// 请选择要解放的卡
+	HINTMSG_RELEASE HintMessage = 500
+	// 请选择要丢弃的手牌
+	HINTMSG_DISCARD HintMessage = 501
+	// 请选择要破坏的卡
+	HINTMSG_DESTROY HintMessage = 502
+	// 请选择要除外的卡
+	HINTMSG_REMOVE HintMessage = 503
+	// 请选择要送去墓地的卡
+	HINTMSG_TOGRAVE HintMessage = 504
+	// 请选择要返回手牌的卡
+	HINTMSG_RTOHAND HintMessage = 505
+	// 请选择要加入手牌的卡
+	HINTMSG_ATOHAND HintMessage = 506
+	// 请选择要返回卡组的卡
+	HINTMSG_TODECK HintMessage = 507
+	// 请选择要召唤的卡
+	HINTMSG_SUMMON HintMessage = 508
+	// 请选择要特殊召唤的卡
+	HINTMSG_SPSUMMON HintMessage = 509
+	// 请选择要盖放的卡
+	HINTMSG_SET HintMessage = 510
+	// 请选择融合召唤的素材
+	HINTMSG_FMATERIAL HintMessage = 511
+	// 请选择同调召唤的素材
+	HINTMSG_SMATERIAL HintMessage = 512
+	// 请选择超量召唤的素材
+	HINTMSG_XMATERIAL HintMessage = 513
+	// 请选择表侧表示的卡
+	HINTMSG_FACEUP HintMessage = 514
+	// 请选择里侧表示的卡
+	HINTMSG_FACEDOWN HintMessage = 515
+	// 请选择攻击表示的怪兽
+	HINTMSG_ATTACK HintMessage = 516
+	// 请选择守备表示的怪兽
+	HINTMSG_DEFENSE HintMessage = 517
+	// 请选择要装备的卡
+	HINTMSG_EQUIP HintMessage = 518
+	// 请选择要取除的超量素材
+	HINTMSG_REMOVEXYZ HintMessage = 519
+	// 请选择要改变控制权的怪兽
+	HINTMSG_CONTROL HintMessage = 520
+	// 请选择要代替破坏的卡
+	HINTMSG_DESREPLACE HintMessage = 521
+	// 请选择表侧攻击表示的怪兽
+	HINTMSG_FACEUPATTACK HintMessage = 522
+	// 请选择表侧守备表示的怪兽
+	HINTMSG_FACEUPDEFENSE HintMessage = 523
+	// 请选择里侧攻击表示的怪兽
+	HINTMSG_FACEDOWNATTACK HintMessage = 524
+	// 请选择里侧守备表示的怪兽
+	HINTMSG_FACEDOWNDEFENSE HintMessage = 525
+	// 请选择给对方确认的卡
+	HINTMSG_CONFIRM HintMessage = 526
+	// 请选择要放置到场上的卡
+	HINTMSG_TOFIELD HintMessage = 527
+	// 请选择要改变表示形式的怪兽
+	HINTMSG_POSCHANGE HintMessage = 528
+	// 请选择自己的卡
+	HINTMSG_SELF HintMessage = 529
+	// 请选择对方的卡
+	HINTMSG_OPPO HintMessage = 530
+	// 请选择攻击的对象
+	HINTMSG_ATTACKTARGET HintMessage = 549
+	// 请选择要发动的效果
+	HINTMSG_EFFECT HintMessage = 550
+	// 请选择效果的对象
+	HINTMSG_TARGET HintMessage = 551
+	// 请选择硬币的正反面
+	HINTMSG_COIN HintMessage = 552
+	// 请选择骰子的结果
+	HINTMSG_DICE HintMessage = 553
+	// 请选择一个种类
+	HINTMSG_CARDTYPE HintMessage = 554
+	// 请选择一个选项
+	HINTMSG_OPTION HintMessage = 555
+	// 请选择
+	HINTMSG_SELECT HintMessage = 560
+	// 请选择表示形式
+	HINTMSG_POSITION HintMessage = 561
+	// 请选择要宣言的属性
+	HINTMSG_ATTRIBUTE HintMessage = 562
+	// 请选择要宣言的种族
+	HINTMSG_RACE HintMessage = 563
+	// 请宣言一个卡名
+	HINTMSG_CODE HintMessage = 564
+	// 请选择一个数字
+	HINGMSG_NUMBER HintMessage = 565
+	// 请宣言一个等级
+	HINGMSG_LVRANK HintMessage = 567
+)
+
+var hintMessageValues = map[string]HintMessage{
+	"release":         HINTMSG_RELEASE,
+	"discard":         HINTMSG_DISCARD,
+	"destroy":         HINTMSG_DESTROY,
+	"remove":          HINTMSG_REMOVE,
+	"tograve":         HINTMSG_TOGRAVE,
+	"rtohand":         HINTMSG_RTOHAND,
+	"atohand":         HINTMSG_ATOHAND,
+	"todeck":          HINTMSG_TODECK,
+	"summon":          HINTMSG_SUMMON,
+	"spsummon":        HINTMSG_SPSUMMON,
+	"set":             HINTMSG_SET,
+	"fmaterial":       HINTMSG_FMATERIAL,
+	"smaterial":       HINTMSG_SMATERIAL,
+	"xmaterial":       HINTMSG_XMATERIAL,
+	"faceup":          HINTMSG_FACEUP,
+	"facedown":        HINTMSG_FACEDOWN,
+	"attack":          HINTMSG_ATTACK,
+	"defense":         HINTMSG_DEFENSE,
+	"equip":           HINTMSG_EQUIP,
+	"removexyz":       HINTMSG_REMOVEXYZ,
+	"control":         HINTMSG_CONTROL,
+	"desreplace":      HINTMSG_DESREPLACE,
+	"faceupattack":    HINTMSG_FACEUPATTACK,
+	"faceupdefense":   HINTMSG_FACEUPDEFENSE,
+	"facedownattack":  HINTMSG_FACEDOWNATTACK,
+	"facedowndefense": HINTMSG_FACEDOWNDEFENSE,
+	"confirm":         HINTMSG_CONFIRM,
+	"tofield":         HINTMSG_TOFIELD,
+	"poschange":       HINTMSG_POSCHANGE,
+	"self":            HINTMSG_SELF,
+	"oppo":            HINTMSG_OPPO,
+	"attacktarget":    HINTMSG_ATTACKTARGET,
+	"effect":          HINTMSG_EFFECT,
+	"target":          HINTMSG_TARGET,
+	"coin":            HINTMSG_COIN,
+	"dice":            HINTMSG_DICE,
+	"cardtype":        HINTMSG_CARDTYPE,
+	"option":          HINTMSG_OPTION,
+	"select":          HINTMSG_SELECT,
+	"position":        HINTMSG_POSITION,
+	"attribute":       HINTMSG_ATTRIBUTE,
+	"race":            HINTMSG_RACE,
+	"code":            HINTMSG_CODE,
+	"number":          HINGMSG_NUMBER,
+	"lvrank":          HINGMSG_LVRANK,
+}
+
+var hintMessageNames = []constantName{
+	{500, "HINTMSG_RELEASE"},
+	{501, "HINTMSG_DISCARD"},
+	{502, "HINTMSG_DESTROY"},
+	{503, "HINTMSG_REMOVE"},
+	{504, "HINTMSG_TOGRAVE"},
+	{505, "HINTMSG_RTOHAND"},
+	{506, "HINTMSG_ATOHAND"},
+	{507, "HINTMSG_TODECK"},
+	{508, "HINTMSG_SUMMON"},
+	{509, "HINTMSG_SPSUMMON"},
+	{510, "HINTMSG_SET"},
+	{511, "HINTMSG_FMATERIAL"},
+	{512, "HINTMSG_SMATERIAL"},
+	{513, "HINTMSG_XMATERIAL"},
+	{514, "HINTMSG_FACEUP"},
+	{515, "HINTMSG_FACEDOWN"},
+	{516, "HINTMSG_ATTACK"},
+	{517, "HINTMSG_DEFENSE"},
+	{518, "HINTMSG_EQUIP"},
+	{519, "HINTMSG_REMOVEXYZ"},
+	{520, "HINTMSG_CONTROL"},
+	{521, "HINTMSG_DESREPLACE"},
+	{522, "HINTMSG_FACEUPATTACK"},
+	{523, "HINTMSG_FACEUPDEFENSE"},
+	{524, "HINTMSG_FACEDOWNATTACK"},
+	{525, "HINTMSG_FACEDOWNDEFENSE"},
+	{526, "HINTMSG_CONFIRM"},
+	{527, "HINTMSG_TOFIELD"},
+	{528, "HINTMSG_POSCHANGE"},
+	{529, "HINTMSG_SELF"},
+	{530, "HINTMSG_OPPO"},
+	{549, "HINTMSG_ATTACKTARGET"},
+	{550, "HINTMSG_EFFECT"},
+	{551, "HINTMSG_TARGET"},
+	{552, "HINTMSG_COIN"},
+	{553, "HINTMSG_DICE"},
+	{554, "HINTMSG_CARDTYPE"},
+	{555, "HINTMSG_OPTION"},
+	{560, "HINTMSG_SELECT"},
+	{561, "HINTMSG_POSITION"},
+	{562, "HINTMSG_ATTRIBUTE"},
+	{563, "HINTMSG_RACE"},
+	{564, "HINTMSG_CODE"},
+	{565, "HINGMSG_NUMBER"},
+	{567, "HINGMSG_LVRANK"},
+}
+
+func (value HintMessage) String() string {
+	return constantString(int64(value), hintMessageNames, "HintMessage")
+}
+
+// Select 选择
+type Select int64
+
+const (
+	// 正面
+	SELECT_HEADS Select = 60
+	// 反面
+	SELECT_TAILS Select = 61
+)
+
+var selectValues = map[string]Select{
+	"heads": SELECT_HEADS,
+	"tails": SELECT_TAILS,
+}
+
+var selectNames = []constantName{
+	{60, "SELECT_HEADS"},
+	{61, "SELECT_TAILS"},
+}
+
+func (value Select) String() string {
+	return constantString(int64(value), selectNames, "Select")
+}
+
+// Timing 提示时点
+type Timing int64
+
+const (
+	// 抽卡阶段时点
+	TIMING_DRAW_PHASE Timing = 0x1
+	// 准备阶段时点
+	TIMING_STANDBY_PHASE Timing = 0x2
+	// 主要阶段结束时点
+	TIMING_MAIN_END Timing = 0x4
+	// 战斗阶段开始时点
+	TIMING_BATTLE_START Timing = 0x8
+	// 战斗阶段结束时点
+	TIMING_BATTLE_END Timing = 0x10
+	// 结束阶段时点
+	TIMING_END_PHASE Timing = 0x20
+	// 召唤时点
+	TIMING_SUMMON Timing = 0x40
+	// 特殊召唤时点
+	TIMING_SPSUMMON Timing = 0x80
+	// 翻转召唤时点
+	TIMING_FLIPSUMMON Timing = 0x100
+	// 放置怪兽时点
+	TIMING_MSET Timing = 0x200
+	// 放置魔陷时点
+	TIMING_SSET Timing = 0x400
+	// 表示形式变更时点
+	TIMING_POS_CHANGE Timing = 0x800
+	// 攻击宣言时点
+	TIMING_ATTACK Timing = 0x1000
+	// 伤害步骤时点
+	TIMING_DAMAGE_STEP Timing = 0x2000
+	// 伤害计算时点
+	TIMING_DAMAGE_CAL Timing = 0x4000
+	// 连锁结束时点
+	TIMING_CHAIN_END Timing = 0x8000
+	// 抽卡时点（不是抽卡阶段
+	TIMING_DRAW Timing = 0x10000
+	// 造成伤害时点
+	TIMING_DAMAGE Timing = 0x20000
+	// 回复时点
+	TIMING_RECOVER Timing = 0x40000
+	// 破坏时点
+	TIMING_DESTROY Timing = 0x80000
+	// 除外时点
+	TIMING_REMOVE Timing = 0x100000
+	// 加入手牌时点（检索、回收等）
+	TIMING_TOHAND Timing = 0x200000
+	// 回卡组时点
+	TIMING_TODECK Timing = 0x400000
+	// 进墓地时点
+	TIMING_TOGRAVE Timing = 0x800000
+	// 战斗阶段时点
+	TIMING_BATTLE_PHASE Timing = 0x1000000
+	// 装备时点
+	TIMING_EQUIP Timing = 0x2000000
+	// 戰鬥步驟結束時
+	TIMING_BATTLE_STEP_END Timing = 0x4000000
+	// 怪兽正面上场
+	TIMINGS_CHECK_MONSTER Timing = 0x1c0
+	// 怪兽正面上场 + EP
+	TIMINGS_CHECK_MONSTER_E Timing = 0x1e0
+)
+
+var timingValues = map[string]Timing{
+	"draw_phase":      TIMING_DRAW_PHASE,
+	"standby_phase":   TIMING_STANDBY_PHASE,
+	"main_end":        TIMING_MAIN_END,
+	"battle_start":    TIMING_BATTLE_START,
+	"battle_end":      TIMING_BATTLE_END,
+	"end_phase":       TIMING_END_PHASE,
+	"summon":          TIMING_SUMMON,
+	"spsummon":        TIMING_SPSUMMON,
+	"flipsummon":      TIMING_FLIPSUMMON,
+	"mset":            TIMING_MSET,
+	"sset":            TIMING_SSET,
+	"pos_change":      TIMING_POS_CHANGE,
+	"attack":          TIMING_ATTACK,
+	"damage_step":     TIMING_DAMAGE_STEP,
+	"damage_cal":      TIMING_DAMAGE_CAL,
+	"chain_end":       TIMING_CHAIN_END,
+	"draw":            TIMING_DRAW,
+	"damage":          TIMING_DAMAGE,
+	"recover":         TIMING_RECOVER,
+	"destroy":         TIMING_DESTROY,
+	"remove":          TIMING_REMOVE,
+	"tohand":          TIMING_TOHAND,
+	"todeck":          TIMING_TODECK,
+	"tograve":         TIMING_TOGRAVE,
+	"battle_phase":    TIMING_BATTLE_PHASE,
+	"equip":           TIMING_EQUIP,
+	"battle_step_end": TIMING_BATTLE_STEP_END,
+	"check_monster":   TIMINGS_CHECK_MONSTER,
+	"check_monster_e": TIMINGS_CHECK_MONSTER_E,
+}
+
+var timingNames = []constantName{
+	{0x1, "TIMING_DRAW_PHASE"},
+	{0x2, "TIMING_STANDBY_PHASE"},
+	{0x4, "TIMING_MAIN_END"},
+	{0x8, "TIMING_BATTLE_START"},
+	{0x10, "TIMING_BATTLE_END"},
+	{0x20, "TIMING_END_PHASE"},
+	{0x40, "TIMING_SUMMON"},
+	{0x80, "TIMING_SPSUMMON"},
+	{0x100, "TIMING_FLIPSUMMON"},
+	{0x200, "TIMING_MSET"},
+	{0x400, "TIMING_SSET"},
+	{0x800, "TIMING_POS_CHANGE"},
+	{0x1000, "TIMING_ATTACK"},
+	{0x2000, "TIMING_DAMAGE_STEP"},
+	{0x4000, "TIMING_DAMAGE_CAL"},
+	{0x8000, "TIMING_CHAIN_END"},
+	{0x10000, "TIMING_DRAW"},
+	{0x20000, "TIMING_DAMAGE"},
+	{0x40000, "TIMING_RECOVER"},
+	{0x80000, "TIMING_DESTROY"},
+	{0x100000, "TIMING_REMOVE"},
+	{0x200000, "TIMING_TOHAND"},
+	{0x400000, "TIMING_TODECK"},
+	{0x800000, "TIMING_TOGRAVE"},
+	{0x1000000, "TIMING_BATTLE_PHASE"},
+	{0x2000000, "TIMING_EQUIP"},
+	{0x4000000, "TIMING_BATTLE_STEP_END"},
+	{0x1c0, "TIMINGS_CHECK_MONSTER"},
+	{0x1e0, "TIMINGS_CHECK_MONSTER_E"},
+}
+
+func (value Timing) String() string {
+	return constantString(int64(value), timingNames, "Timing")
+}
+
+// GlobalFlag 特殊标记
+type GlobalFlag int64
+
+const (
+	// 卡组翻转标记
+	GLOBALFLAG_DECK_REVERSE_CHECK GlobalFlag = 0x1
+	// 洗脑解除标记
+	GLOBALFLAG_BRAINWASHING_CHECK GlobalFlag = 0x2
+	// 废铁奇美拉标记
+	GLOBALFLAG_SCRAP_CHIMERA GlobalFlag = 0x4
+	// N/A
+	GLOBALFLAG_DELAYED_QUICKEFFECT GlobalFlag = 0x8
+	// EVENT_DETACH_MATERIAL
+	GLOBALFLAG_DETACH_EVENT GlobalFlag = 0x10
+	// 必须作为同调素材（波动龙 声子龙）
+	GLOBALFLAG_MUST_BE_SMATERIAL GlobalFlag = 0x20
+	// 玩家的特殊召唤次数限制
+	GLOBALFLAG_SPSUMMON_COUNT GlobalFlag = 0x40
+	// 超量素材数量限制标记（光天使 天座）
+	GLOBALFLAG_XMAT_COUNT_LIMIT GlobalFlag = 0x80
+	// 不入連鎖的送墓檢查(EFFECT_SELF_TOGRAVE)
+	GLOBALFLAG_SELF_TOGRAVE GlobalFlag = 0x100
+	// 1回合只能特殊召喚1次(Card.SetSPSummonOnce())
+	GLOBALFLAG_SPSUMMON_ONCE GlobalFlag = 0x200
+	GLOBALFLAG_TUNE_MAGICIAN GlobalFlag = 0x400
+)
+
+var globalFlagValues = map[string]GlobalFlag{
+	"deck_reverse_check":  GLOBALFLAG_DECK_REVERSE_CHECK,
+	"brainwashing_check":  GLOBALFLAG_BRAINWASHING_CHECK,
+	"scrap_chimera":       GLOBALFLAG_SCRAP_CHIMERA,
+	"delayed_quickeffect": GLOBALFLAG_DELAYED_QUICKEFFECT,
+	"detach_event":        GLOBALFLAG_DETACH_EVENT,
+	"must_be_smaterial":   GLOBALFLAG_MUST_BE_SMATERIAL,
+	"spsummon_count":      GLOBALFLAG_SPSUMMON_COUNT,
+	"xmat_count_limit":    GLOBALFLAG_XMAT_COUNT_LIMIT,
+	"self_tograve":        GLOBALFLAG_SELF_TOGRAVE,
+	"spsummon_once":       GLOBALFLAG_SPSUMMON_ONCE,
+	"tune_magician":       GLOBALFLAG_TUNE_MAGICIAN,
+}
+
+var globalFlagNames = []constantName{
+	{0x1, "GLOBALFLAG_DECK_REVERSE_CHECK"},
+	{0x2, "GLOBALFLAG_BRAINWASHING_CHECK"},
+	{0x4, "GLOBALFLAG_SCRAP_CHIMERA"},
+	{0x8, "GLOBALFLAG_DELAYED_QUICKEFFECT"},
+	{0x10, "GLOBALFLAG_DETACH_EVENT"},
+	{0x20, "GLOBALFLAG_MUST_BE_SMATERIAL"},
+	{0x40, "GLOBALFLAG_SPSUMMON_COUNT"},
+	{0x80, "GLOBALFLAG_XMAT_COUNT_LIMIT"},
+	{0x100, "GLOBALFLAG_SELF_TOGRAVE"},
+	{0x200, "GLOBALFLAG_SPSUMMON_ONCE"},
+	{0x400, "GLOBALFLAG_TUNE_MAGICIAN"},
+}
+
+func (value GlobalFlag) String() string {
+	return constantString(int64(value), globalFlagNames, "GlobalFlag")
+}
+
+// DuelOption 决斗选项
+type DuelOption int64
+
+const (
+	// 测试模式(目前暫無)
+	DUEL_TEST_MODE DuelOption = 0x01
+	// 第一回合可以攻击(用于残局)
+	DUEL_ATTACK_FIRST_TURN DuelOption = 0x02
+	// N/A
+	DUEL_NO_CHAIN_HINT DuelOption = 0x04
+	// 使用舊規則
+	DUEL_OBSOLETE_RULING DuelOption = 0x08
+	// 不洗牌
+	DUEL_PSEUDO_SHUFFLE DuelOption = 0x10
+	// 双打PP
+	DUEL_TAG_MODE DuelOption = 0x20
+	// AI(用于残局)
+	DUEL_SIMPLE_AI DuelOption = 0x40
+)
+
+var duelOptionValues = map[string]DuelOption{
+	"test_mode":         DUEL_TEST_MODE,
+	"attack_first_turn": DUEL_ATTACK_FIRST_TURN,
+	"no_chain_hint":     DUEL_NO_CHAIN_HINT,
+	"obsolete_ruling":   DUEL_OBSOLETE_RULING,
+	"pseudo_shuffle":    DUEL_PSEUDO_SHUFFLE,
+	"tag_mode":          DUEL_TAG_MODE,
+	"simple_ai":         DUEL_SIMPLE_AI,
+}
+
+var duelOptionNames = []constantName{
+	{0x01, "DUEL_TEST_MODE"},
+	{0x02, "DUEL_ATTACK_FIRST_TURN"},
+	{0x04, "DUEL_NO_CHAIN_HINT"},
+	{0x08, "DUEL_OBSOLETE_RULING"},
+	{0x10, "DUEL_PSEUDO_SHUFFLE"},
+	{0x20, "DUEL_TAG_MODE"},
+	{0x40, "DUEL_SIMPLE_AI"},
+}
+
+func (value DuelOption) String() string {
+	return constantString(int64(value), duelOptionNames, "DuelOption")
+}
+
+// Activity 活动计数器
+type Activity int64
+
+const (
+	ACTIVITY_SUMMON       Activity = 1
+	ACTIVITY_NORMALSUMMON Activity = 2
+	ACTIVITY_SPSUMMON     Activity = 3
+	ACTIVITY_FLIPSUMMON   Activity = 4
+	ACTIVITY_ATTACK       Activity = 5
+	// not available in custom counter
+	ACTIVITY_BATTLE_PHASE Activity = 6
+	// only available in custom counter
+	ACTIVITY_CHAIN Activity = 7
+)
+
+var activityValues = map[string]Activity{
+	"summon":       ACTIVITY_SUMMON,
+	"normalsummon": ACTIVITY_NORMALSUMMON,
+	"spsummon":     ACTIVITY_SPSUMMON,
+	"flipsummon":   ACTIVITY_FLIPSUMMON,
+	"attack":       ACTIVITY_ATTACK,
+	"battle_phase": ACTIVITY_BATTLE_PHASE,
+	"chain":        ACTIVITY_CHAIN,
+}
+
+var activityNames = []constantName{
+	{1, "ACTIVITY_SUMMON"},
+	{2, "ACTIVITY_NORMALSUMMON"},
+	{3, "ACTIVITY_SPSUMMON"},
+	{4, "ACTIVITY_FLIPSUMMON"},
+	{5, "ACTIVITY_ATTACK"},
+	{6, "ACTIVITY_BATTLE_PHASE"},
+	{7, "ACTIVITY_CHAIN"},
+}
+
+func (value Activity) String() string {
+	return constantString(int64(value), activityNames, "Activity")
+}
+
+// AnnounceType 宣言类型
+type AnnounceType int64
+
+const (
+	// 宣言卡片
+	ANNOUNCE_CARD        AnnounceType = 0x7
+	ANNOUNCE_CARD_FILTER AnnounceType = 0x8
+)
+
+var announceTypeValues = map[string]AnnounceType{
+	"card":        ANNOUNCE_CARD,
+	"card_filter": ANNOUNCE_CARD_FILTER,
+}
+
+var announceTypeNames = []constantName{
+	{0x7, "ANNOUNCE_CARD"},
+	{0x8, "ANNOUNCE_CARD_FILTER"},
+}
+
+func (value AnnounceType) String() string {
+	return constantString(int64(value), announceTypeNames, "AnnounceType")
+}
@@ -6,25 +6,31 @@ import (
 	_ "github.com/mattn/go-sqlite3"
 	"io/ioutil"
 	"log"
-	"path/filepath"
+	"math/bits"
 	"regexp"
 	"strconv"
 	"strings"
-	"os"
+	"sync"
 )
 
-// SQL 卡片查询指令
-const READ_DATA_SQL = "select * from datas join texts on datas.Id == texts.Id where datas.Id == (?)"
-const READ_ALL_DATA_SQL = "select * from datas join texts on datas.Id == texts.Id"
-
 // SQL 系列查询指令
 const QUERY_SET_SQL = "select Id from datas where (Setcode & 0x0000000000000FFF == (?) or Setcode & 0x000000000FFF0000 == (?) or Setcode & 0x00000FFF00000000 == (?) or Setcode & 0x0FFF000000000000 == (?))"
 const QUERY_SUBSET_SQL = "select Id from datas where (Setcode & 0x000000000000FFFF == (?) or Setcode & 0x00000000FFFF0000 == (?) or Setcode & 0x0000FFFF00000000 == (?) or Setcode & 0xFFFF000000000000 == (?))"
 
+// SQL 系列扫描指令
+const READ_ALL_SETCODE_SQL = "select Id, Setcode from datas where Setcode != 0"
+const READ_ALL_ID_SQL = "select id from datas"
+
 // SQL 卡片查询指令
 const SEARCH_NAME_ACCURATE_SQL = "select id from texts where name == (?)"
 const SEARCH_NAME_SQL = "select id from texts where name like (?)"
 
+// SQL 效果文本查询指令
+const SEARCH_DESC_SQL = "select id from texts where desc like (?)"
+
+// SQL 效果分类查询指令
+const QUERY_CATEGORY_SQL = "select Id from datas where Category & (?) == (?)"
+
 type property struct {
 	name   string
 	text   string
@@ -36,48 +42,127 @@ type Environment struct {
 	Cards  map[int]Card
 	Locale string
 	dbs    []*sql.DB
+	// Cards 中已包含所有层的全部卡片
+	cardsLoaded bool
 
-	attributeNames []string
-	raceNames      []string
-	typeNames      []string
+	systemStrings  map[int64]string
+	victoryStrings map[int64]string
+	counterNames   map[int64]string
 
 	Attributes map[string]property
 	Races      map[string]property
 	Types      map[string]property
+	Categories map[string]property
 	Sets       []Set
+
+	// 无法打开的 cdb、无法读取的卡片行，以及 strings.conf 与常量对不上的条目（见 linkStringsAndConstants）
+	Warnings []string
+
+	setsByCode map[int64]*Set
+	setsByName map[string]*Set
+	setsOfCard map[int][]*Set
+	setsLinked bool
+	setsLock   sync.Mutex
+
+	options    EnvironmentOptions
+	layers     []*Layer
+	layersLock sync.RWMutex
 }
 
 // 构造函数
 
 var Environments map[string]*Environment = make(map[string]*Environment)
-var DatabasePath = filepath.Join(os.Getenv("GOPATH"), "src/github.com/iamipanda/ygopro-data/ygopro-database/locales/")
-var LuaPath = filepath.Join(os.Getenv("GOPATH"), "src/github.com/iamipanda/ygopro-data/Constant.lua")
+
+// Reload 与 Watcher 会在其他 goroutine 中替换 Environments 中的环境
+var environmentsLock sync.RWMutex
 
 func GetEnvironment(locale string) *Environment {
+	return GetEnvironmentWithOptions(locale, DefaultEnvironmentOptions)
+}
+
+// 选项只在第一次创建该语言的环境时生效
+func GetEnvironmentWithOptions(locale string, options EnvironmentOptions) *Environment {
+	environmentsLock.RLock()
+	environment, has := Environments[locale]
+	environmentsLock.RUnlock()
+	if has {
+		return environment
+	}
+	environmentsLock.Lock()
+	defer environmentsLock.Unlock()
 	if environment, has := Environments[locale]; has {
 		return environment
 	} else {
-		return newEnvironment(locale)
+		environment = newEnvironment(locale, options)
+		Environments[locale] = environment
+		return environment
 	}
 }
 
-func newEnvironment(locale string) (environment *Environment) {
+func newEnvironment(locale string, options EnvironmentOptions) (environment *Environment) {
 	environment = new(Environment)
-	environment.dbs = searchCdb(locale)
-	environment.Cards = make(map[int]Card)
+	environment.options = options
 	environment.Locale = locale
-	environment.loadStringsFile(filepath.Join(DatabasePath, locale, "strings.conf"))
+	environment.Cards = make(map[int]Card)
+	environment.searchCdb()
+	environment.loadStringsFile(options.join(options.databasePath(), locale, "strings.conf"))
 	environment.linkStringsAndConstants()
-	environment.linkSetNameToSQL()
-	Environments[locale] = environment
+	environment.linkSetHierarchy()
+	environment.indexSets()
+	if !options.LazySets {
+		environment.LinkSets()
+	}
+	if options.PreloadCards {
+		environment.LoadAllCards()
+	}
 	return
 }
 
+// 释放数据库连接；关闭后只能使用已缓存的卡片
+func (environment *Environment) Close() error {
+	environment.layersLock.Lock()
+	layers := environment.layers
+	environment.layers = nil
+	environment.dbs = nil
+	environment.layersLock.Unlock()
+	var answer error
+	for _, layer := range layers {
+		if err := layer.close(); err != nil && answer == nil {
+			answer = err
+		}
+	}
+	return answer
+}
+
+func RemoveEnvironment(locale string) error {
+	environmentsLock.Lock()
+	environment, has := Environments[locale]
+	delete(Environments, locale)
+	environmentsLock.Unlock()
+	if has {
+		return environment.Close()
+	}
+	return nil
+}
+
 // 静态初始化（读取 Constants.lua）
+// 未调用 InitializeStaticEnvironment 时使用生成的常量
 var attributeConstants []property = make([]property, 0, 10)
 var raceConstants []property = make([]property, 0, 40)
 var typeConstants []property = make([]property, 0, 40)
 
+func init() {
+	for _, constant := range attributeNames {
+		attributeConstants = checkAndAddConstant(constant.name, constant.value, "ATTRIBUTE_", attributeConstants)
+	}
+	for _, constant := range raceNames {
+		raceConstants = checkAndAddConstant(constant.name, constant.value, "RACE_", raceConstants)
+	}
+	for _, constant := range cardTypeNames {
+		typeConstants = checkAndAddConstant(constant.name, constant.value, "TYPE_", typeConstants)
+	}
+}
+
 func InitializeStaticEnvironment() {
 	loadLuaFile(LuaPath)
 	// register_methods
@@ -95,6 +180,9 @@ func loadLuaFile(filePath string) {
 }
 
 func loadLuaLines(stringFile string) {
+	attributeConstants = attributeConstants[:0]
+	raceConstants = raceConstants[:0]
+	typeConstants = typeConstants[:0]
 	lines := strings.Split(stringFile, "\n")
 	for _, line := range lines {
 		if strings.HasPrefix(line, "--") {
@@ -110,19 +198,20 @@ func loadLuaLines(stringFile string) {
 	}
 }
 
-var luaLineRegex, _ = regexp.Compile(`([A-Z_]+)\s*=\s*0x(\d+)`)
+var luaLineRegex, _ = regexp.Compile(`^([A-Z][A-Z0-9_]*)\s*=\s*(0x[0-9a-fA-F]+|\d+)`)
 
 func loadLuaLinePattern(line string) (string, int64, bool) {
 	if match := luaLineRegex.FindStringSubmatch(line); match == nil {
 		return "", -1, true
 	} else {
-		value, _ := strconv.ParseInt(match[2], 16, 64)
+		value, _ := strconv.ParseInt(match[2], 0, 64)
 		return match[1], value, false
 	}
 }
 
+// 只保留单个位的常量，RACE_ALL 之类的组合值在 strings.conf 中没有对应名称
 func checkAndAddConstant(name string, value int64, prefix string, target []property) []property {
-	if strings.HasPrefix(name, prefix) {
+	if strings.HasPrefix(name, prefix) && value > 0 && value&(value-1) == 0 {
 		name = strings.ToLower(name[len(prefix):])
 		target = append(target, property{name: name, value: value})
 	}
@@ -131,7 +220,7 @@ func checkAndAddConstant(name string, value int64, prefix string, target []prope
 
 // 读取 strings 文件步骤
 func (environment *Environment) loadStringsFile(filePath string) {
-	bytes, err := ioutil.ReadFile(filePath)
+	bytes, err := environment.options.readFile(filePath)
 	if err != nil {
 		fmt.Printf("%v", err)
 		return
@@ -141,21 +230,30 @@ func (environment *Environment) loadStringsFile(filePath string) {
 }
 
 func (environment *Environment) loadStringsLines(string_file string) {
+	environment.systemStrings = make(map[int64]string)
+	environment.victoryStrings = make(map[int64]string)
+	environment.counterNames = make(map[int64]string)
 	lines := strings.Split(string_file, "\n")
 	for _, line := range lines {
+		line = strings.TrimRight(line, "\r")
 		switch {
-		case strings.HasPrefix(line, "!system 10"):
+		case strings.HasPrefix(line, "!system"):
 			if systemNumber, text, err := environment.loadStringsLinePattern(line); err {
 				continue
 			} else {
-				switch {
-				case isAttributeName(systemNumber):
-					environment.attributeNames = append(environment.attributeNames, text)
-				case isRaceName(systemNumber):
-					environment.raceNames = append(environment.raceNames, text)
-				case isTypeName(systemNumber):
-					environment.typeNames = append(environment.typeNames, text)
-				}
+				environment.systemStrings[systemNumber] = text
+			}
+		case strings.HasPrefix(line, "!victory"):
+			if code, text, err := loadCodeLinePattern(victoryLineReg, line); err {
+				continue
+			} else {
+				environment.victoryStrings[code] = text
+			}
+		case strings.HasPrefix(line, "!counter"):
+			if code, text, err := loadCodeLinePattern(counterLineReg, line); err {
+				continue
+			} else {
+				environment.counterNames[code] = text
 			}
 		case strings.HasPrefix(line, "!setname"):
 			if setCode, setName, err := environment.loadSetnameLinePattern(line); err {
@@ -167,22 +265,30 @@ func (environment *Environment) loadStringsLines(string_file string) {
 	}
 }
 
-func isAttributeName(systemNumber int64) bool {
-	return systemNumber >= 1010 && systemNumber < 1020
+// !system 提示文本，如阶段名、提示消息、效果分类名称
+func (environment *Environment) SystemString(id int64) (string, bool) {
+	text, exist := environment.systemStrings[id]
+	return text, exist
 }
 
-func isRaceName(systemNumber int64) bool {
-	return systemNumber >= 1020 && systemNumber < 1050
+// !victory 胜利原因
+func (environment *Environment) VictoryString(code int64) (string, bool) {
+	text, exist := environment.victoryStrings[code]
+	return text, exist
 }
 
-func isTypeName(systemNumber int64) bool {
-	return systemNumber >= 1050 && systemNumber < 1080 && systemNumber != 1053 && systemNumber != 1065
+// !counter 指示物名称
+func (environment *Environment) CounterName(code int64) (string, bool) {
+	text, exist := environment.counterNames[code]
+	return text, exist
 }
 
 var stringsLineReg, _ = regexp.Compile(`!system (\d+) (.+)`)
 var setnameLineReg, _ = regexp.Compile(`!setname 0x([0-9a-fA-F]+) (.+)`)
+var victoryLineReg, _ = regexp.Compile(`!victory (0x[0-9a-fA-F]+|\d+) (.+)`)
+var counterLineReg, _ = regexp.Compile(`!counter (0x[0-9a-fA-F]+|\d+) (.+)`)
 
-func (Environment) loadStringsLinePattern(line string) (int64, string, bool) {
+func (*Environment) loadStringsLinePattern(line string) (int64, string, bool) {
 	if submatches := stringsLineReg.FindStringSubmatch(line); submatches == nil {
 		return 0, "", true
 	} else {
@@ -191,7 +297,16 @@ func (Environment) loadStringsLinePattern(line string) (int64, string, bool) {
 	}
 }
 
-func (Environment) loadSetnameLinePattern(line string) (int64, string, bool) {
+func loadCodeLinePattern(reg *regexp.Regexp, line string) (int64, string, bool) {
+	if submatches := reg.FindStringSubmatch(line); submatches == nil {
+		return 0, "", true
+	} else {
+		value, _ := strconv.ParseInt(submatches[1], 0, 64)
+		return value, submatches[2], false
+	}
+}
+
+func (*Environment) loadSetnameLinePattern(line string) (int64, string, bool) {
 	if submatches := setnameLineReg.FindStringSubmatch(line); submatches == nil {
 		return 0, "", true
 	} else {
@@ -201,68 +316,100 @@ func (Environment) loadSetnameLinePattern(line string) (int64, string, bool) {
 }
 
 // 连接步骤
-func (environment *Environment) linkStringsAndConstants() {
-	environment.linkStringsAndConstantsPattern(environment.attributeNames, attributeConstants, &environment.Attributes)
-	environment.linkStringsAndConstantsPattern(environment.raceNames, raceConstants, &environment.Races)
-	environment.linkStringsAndConstantsPattern(environment.typeNames, typeConstants, &environment.Types)
+// 第 n 位的常量对应 !system base+n，例如 TYPE_QUICKPLAY (0x10000) 对应 !system 1066
+const ATTRIBUTE_SYSTEM_STRING_BASE = 1010
+const RACE_SYSTEM_STRING_BASE = 1020
+const TYPE_SYSTEM_STRING_BASE = 1050
 
-	// Log
+func (environment *Environment) linkStringsAndConstants() {
+	environment.linkStringsAndConstantsPattern("ATTRIBUTE_", ATTRIBUTE_SYSTEM_STRING_BASE, RACE_SYSTEM_STRING_BASE, attributeConstants, &environment.Attributes)
+	environment.linkStringsAndConstantsPattern("RACE_", RACE_SYSTEM_STRING_BASE, TYPE_SYSTEM_STRING_BASE, raceConstants, &environment.Races)
+	environment.linkStringsAndConstantsPattern("TYPE_", TYPE_SYSTEM_STRING_BASE, TYPE_SYSTEM_STRING_BASE+32, typeConstants, &environment.Types)
+	environment.linkCategoryNames()
 }
 
-func (environment *Environment) linkStringsAndConstantsPattern(strings []string, constants []property, target *map[string]property) {
+// 按位计算编号连接名称与常量；没有名称的常量、没有常量的编号都记录到 Warnings
+func (environment *Environment) linkStringsAndConstantsPattern(prefix string, base int64, end int64, constants []property, target *map[string]property) {
 	*target = make(map[string]property)
-	for i := 0; i < len(strings) && i < len(constants); i++ {
-		constant := constants[i]
-		(*target)[constant.name] = property{constant.name, strings[i], constant.value, environment.Locale}
+	linked := make(map[int64]bool)
+	for _, constant := range constants {
+		systemNumber := base + int64(bits.TrailingZeros64(uint64(constant.value)))
+		linked[systemNumber] = true
+		if text, exist := environment.systemStrings[systemNumber]; exist {
+			(*target)[constant.name] = property{constant.name, text, constant.value, environment.Locale}
+		} else {
+			environment.Warnings = append(environment.Warnings, fmt.Sprintf("%v%v (0x%x): missing !system %v", prefix, strings.ToUpper(constant.name), constant.value, systemNumber))
+		}
+	}
+	for systemNumber := base; systemNumber < end; systemNumber++ {
+		if text, exist := environment.systemStrings[systemNumber]; exist && !linked[systemNumber] {
+			environment.Warnings = append(environment.Warnings, fmt.Sprintf("!system %v %v: no %v constant", systemNumber, text, prefix))
+		}
 	}
 }
 
-// 建立 SQL 连接
-func searchCdb(locale string) []*sql.DB {
-	if dbPath, err := filepath.Glob(filepath.Join(DatabasePath, locale, "/*.cdb")); err != nil {
-		return nil
-	} else {
-		dbs := make([]*sql.DB, 0)
-		for _, path := range dbPath {
-			if db, err := sql.Open("sqlite3", path); err != nil {
-				fmt.Printf("%v", err)
-				continue
-			} else {
-				dbs = append(dbs, db)
-			}
+// 建立 SQL 连接：语言目录中的 cdb 按文件名归入各层，ExtraCdbs 作为自定义层
+// 无法打开的 cdb 记录到 Warnings
+func (environment *Environment) searchCdb() {
+	options := environment.options
+	dbPath, err := options.glob(options.join(options.databasePath(), environment.Locale, "*.cdb"))
+	if err != nil {
+		dbPath = nil
+	}
+	for _, path := range dbPath {
+		if layer, err := environment.openLayer(layerName(path), path, classifyCdb(path)); err != nil {
+			environment.Warnings = append(environment.Warnings, fmt.Sprintf("%v: %v", layerName(path), err))
+		} else {
+			environment.insertLayer(layer)
 		}
-		return dbs
 	}
+	for _, path := range options.ExtraCdbs {
+		if layer, err := environment.openLayer(layerName(path), path, LAYER_PRIORITY_CUSTOM); err != nil {
+			environment.Warnings = append(environment.Warnings, fmt.Sprintf("%v: %v", layerName(path), err))
+		} else {
+			environment.insertLayer(layer)
+		}
+	}
+	environment.rebuildDbs()
 }
 
-// 字段探查
+// 字段探查：每层扫描一次 datas 表，按 Card.IsSetCard 的规则填充所有系列
+// 每张卡片只按包含它的最高优先级的层计算
 func (environment *Environment) linkSetNameToSQL() {
+	bases := make(map[int64][]*Set)
 	for i := range environment.Sets {
-		var ids []int
-		for _, db := range environment.dbs {
-			for _, id := range getIdsBySetCode(db, environment.Sets[i].Code) {
-				ids = append(ids, id)
+		set := &environment.Sets[i]
+		set.Ids = make([]int, 0)
+		bases[set.Code&0xfff] = append(bases[set.Code&0xfff], set)
+	}
+	for i := len(environment.layers) - 1; i >= 0; i-- {
+		rows, err := environment.layers[i].db.Query(READ_ALL_SETCODE_SQL)
+		if err != nil {
+			fmt.Printf("%v", err)
+			continue
+		}
+		for rows.Next() {
+			var card Card
+			if err := rows.Scan(&card.Id, &card.Setcode); err != nil || environment.shadowed(card.Id, i) {
+				continue
+			}
+			for _, code := range card.SetCodes() {
+				for _, set := range bases[code&0xfff] {
+					if !card.IsSetCard(set.Code) {
+						continue
+					}
+					// 同一张卡的多个系列代码可能命中同一个系列
+					if length := len(set.Ids); length == 0 || set.Ids[length-1] != card.Id {
+						set.Ids = append(set.Ids, card.Id)
+					}
+				}
 			}
 		}
-		environment.Sets[i].Ids = ids
-	}
-}
-
-func getIdsBySetCode(db *sql.DB, setCode int64) []int {
-	var sqlQuery string
-	if setCode < 0xFFF {
-		sqlQuery = QUERY_SET_SQL
-	} else {
-		sqlQuery = QUERY_SUBSET_SQL
+		rows.Close()
 	}
-	rows, _ := db.Query(sqlQuery, setCode, setCode<<16, setCode<<32, setCode<<48)
-	var ids []int
-	var id int
-	for rows.Next() {
-		rows.Scan(&id)
-		ids = append(ids, id)
+	for i := range environment.Sets {
+		environment.Sets[i].Ids = uniqueSortedIds(environment.Sets[i].Ids)
 	}
-	return ids
 }
 
 // 获取卡片
@@ -280,25 +427,11 @@ func (environment *Environment) GetCard(id int) (Card, bool) {
 
 // 根据名称获取卡片
 func (environment *Environment) GetNamedCard(name string) (Card, bool) {
-	for _, db := range environment.dbs {
-		rows, _ := db.Query(SEARCH_NAME_ACCURATE_SQL, name)
-		answer := rows.Next()
-		if answer {
-			var id int
-			rows.Scan(&id)
-			return environment.GetCard(id)
-		}
-		rows.Close()
+	if ids := environment.queryIds(SEARCH_NAME_ACCURATE_SQL, name); len(ids) > 0 {
+		return environment.GetCard(ids[0])
 	}
-	for _, db := range environment.dbs {
-		rows, _ := db.Query(SEARCH_NAME_SQL, "%"+name+"%")
-		answer := rows.Next()
-		if answer {
-			var id int
-			rows.Scan(&id)
-			return environment.GetCard(id)
-		}
-		rows.Close()
+	if ids := environment.queryIds(SEARCH_NAME_SQL, "%"+name+"%"); len(ids) > 0 {
+		return environment.GetCard(ids[0])
 	}
 	return Card{}, false
 }
@@ -327,25 +460,41 @@ func (environment *Environment) GetAllNamedCard(name string) Set {
 	if len(name) == 0 {
 		return Set{}
 	}
-	var id int
+	return createIdSet(environment.Locale, name, environment.queryIds(SEARCH_NAME_SQL, "%"+name+"%"))
+}
+
+// 效果文本中用引号提及系列名的卡片（「」、"" 或 “”），即该系列的支援卡
+var setNameQuotes = [][2]string{{"「", "」"}, {"\"", "\""}, {"“", "”"}}
+
+func (environment *Environment) GetAllSetMentionedCard(set Set) Set {
 	var ids []int
-	for _, db := range environment.dbs {
-		rows, _ := db.Query(SEARCH_NAME_SQL, "%"+name+"%")
-		answer := rows.Next()
-		for ; answer; answer = rows.Next() {
-			rows.Scan(&id)
-			ids = append(ids, id)
+	for _, name := range []string{set.Name, set.OriginName} {
+		if len(name) == 0 {
+			continue
+		}
+		for _, quote := range setNameQuotes {
+			ids = append(ids, environment.queryIds(SEARCH_DESC_SQL, "%"+quote[0]+name+quote[1]+"%")...)
 		}
 	}
-	return Set{environment.Locale, name, 0,ids, ""}
+	return createIdSet(environment.Locale, set.Name, ids)
 }
 
+// 从优先级最高的层开始查找
 func (environment *Environment) generateCard(id int) (Card, bool) {
-	for _, db := range environment.dbs {
-		rows, _ := db.Query(READ_DATA_SQL, id)
-		answer := rows.Next()
-		if answer {
-			card := createCardFromData(environment.Locale, rows)
+	for i := len(environment.layers) - 1; i >= 0; i-- {
+		layer := environment.layers[i]
+		rows, err := layer.db.Query(layer.selectSql+" where datas.id == (?)", id)
+		if err != nil {
+			continue
+		}
+		if rows.Next() {
+			card, err := createCardFromData(environment.Locale, rows)
+			rows.Close()
+			if err != nil {
+				environment.Warnings = append(environment.Warnings, fmt.Sprintf("%v: card %v: %v", layer.Name, id, err))
+				continue
+			}
+			card.Source = layer.Name
 			environment.Cards[card.Id] = card
 			return card, true
 		}
@@ -354,18 +503,31 @@ func (environment *Environment) generateCard(id int) (Card, bool) {
 	return Card{}, false
 }
 
+// 从优先级最低的层开始读取，高优先级层中的同 id 卡片覆盖之前的结果
+// 无法读取的行跳过并记录到 Warnings
 func (environment *Environment) LoadAllCards() {
-	for _, db := range environment.dbs {
-		rows, _ := db.Query(READ_ALL_DATA_SQL)
-		for rows.Next() {
-			card := createCardFromData(environment.Locale, rows)
+	for _, layer := range environment.layers {
+		rows, err := layer.db.Query(layer.selectSql)
+		if err != nil {
+			continue
+		}
+		for row := 1; rows.Next(); row++ {
+			card, err := createCardFromData(environment.Locale, rows)
+			if err != nil {
+				environment.Warnings = append(environment.Warnings, fmt.Sprintf("%v: row %v: %v", layer.Name, row, err))
+				continue
+			}
+			card.Source = layer.Name
 			environment.Cards[card.Id] = card
 		}
 		rows.Close()
 	}
+	environment.cardsLoaded = true
 }
 
 func LoadAllEnvironmentCards() {
+	environmentsLock.RLock()
+	defer environmentsLock.RUnlock()
 	for _, environment := range Environments {
 		environment.LoadAllCards()
 	}
@@ -378,10 +540,8 @@ func (property *property) IsAttribute(card Card) bool {
 }
 
 func (card Card) IsAttribute(attributeName string) bool {
-	attributeName = strings.ToLower(attributeName)
-	environment := GetEnvironment(card.Locale)
-	if attribute, exist := environment.Attributes[attributeName]; exist {
-		return attribute.IsAttribute(card)
+	if attribute, exist := attributeValues[strings.ToLower(attributeName)]; exist {
+		return card.HasAttribute(attribute)
 	} else {
 		return false
 	}
@@ -392,10 +552,8 @@ func (property *property) IsRace(card Card) bool {
 }
 
 func (card Card) IsRace(raceName string) bool {
-	raceName = strings.ToLower(raceName)
-	environment := GetEnvironment(card.Locale)
-	if attribute, exist := environment.Races[raceName]; exist {
-		return attribute.IsRace(card)
+	if race, exist := raceValues[strings.ToLower(raceName)]; exist {
+		return card.HasRace(race)
 	} else {
 		return false
 	}
@@ -406,10 +564,8 @@ func (property *property) IsType(card Card) bool {
 }
 
 func (card Card) IsType(typeName string) bool {
-	typeName = strings.ToLower(typeName)
-	environment := GetEnvironment(card.Locale)
-	if attribute, exist := environment.Types[typeName]; exist {
-		return attribute.IsType(card)
+	if cardType, exist := cardTypeValues[strings.ToLower(typeName)]; exist {
+		return card.HasType(cardType)
 	} else {
 		return false
 	}
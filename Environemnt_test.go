@@ -0,0 +1,172 @@
+package ygopro_data
+
+import (
+	"database/sql"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+var testAttributeConstants = []property{
+	{name: "earth", value: 0x1},
+	{name: "water", value: 0x2},
+	{name: "fire", value: 0x4},
+}
+
+func linkTestAttributes(stringsFile string) *Environment {
+	environment := &Environment{Locale: "test"}
+	environment.loadStringsLines(stringsFile)
+	environment.linkStringsAndConstantsPattern("ATTRIBUTE_", ATTRIBUTE_SYSTEM_STRING_BASE, RACE_SYSTEM_STRING_BASE, testAttributeConstants, &environment.Attributes)
+	return environment
+}
+
+func TestLinkStringsReordered(t *testing.T) {
+	environment := linkTestAttributes("!system 1012 FIRE\r\n#comment\n!system 1010 EARTH\r\n!setname 0x1 Set\n!system 1011 WATER\n")
+	want := map[string]string{"earth": "EARTH", "water": "WATER", "fire": "FIRE"}
+	if len(environment.Attributes) != len(want) {
+		t.Fatalf("linked %v attributes, want %v", len(environment.Attributes), len(want))
+	}
+	for name, text := range want {
+		if property := environment.Attributes[name]; property.text != text {
+			t.Errorf("%v linked to %q, want %q", name, property.text, text)
+		}
+	}
+	if len(environment.Warnings) > 0 {
+		t.Errorf("unexpected warnings %v", environment.Warnings)
+	}
+}
+
+func TestLinkStringsPartial(t *testing.T) {
+	environment := linkTestAttributes("!system 1013 WIND\n!system 1012 FIRE\n!system 1010 EARTH\n")
+	if property, exist := environment.Attributes["fire"]; !exist || property.text != "FIRE" || property.value != 0x4 {
+		t.Errorf("fire linked to %+v", property)
+	}
+	if _, exist := environment.Attributes["water"]; exist {
+		t.Errorf("water should not be linked")
+	}
+	want := []string{
+		"ATTRIBUTE_WATER (0x2): missing !system 1011",
+		"!system 1013 WIND: no ATTRIBUTE_ constant",
+	}
+	if !reflect.DeepEqual(environment.Warnings, want) {
+		t.Errorf("warnings = %q, want %q", environment.Warnings, want)
+	}
+}
+
+func TestLinkStringsAndConstants(t *testing.T) {
+	environment := &Environment{Locale: "test"}
+	environment.loadStringsLines("!system 1066 Quick-Play\n!system 1020 Warrior\n!system 1014 LIGHT\n")
+	environment.linkStringsAndConstants()
+	if text := environment.Types["quickplay"].text; text != "Quick-Play" {
+		t.Errorf("quickplay linked to %q", text)
+	}
+	if text := environment.Races["warrior"].text; text != "Warrior" {
+		t.Errorf("warrior linked to %q", text)
+	}
+	if text := environment.Attributes["light"].text; text != "LIGHT" {
+		t.Errorf("light linked to %q", text)
+	}
+	warnings := make(map[string]bool)
+	for _, warning := range environment.Warnings {
+		warnings[warning] = true
+	}
+	for _, warning := range []string{"ATTRIBUTE_DARK (0x20): missing !system 1015", "TYPE_LINK (0x4000000): missing !system 1076"} {
+		if !warnings[warning] {
+			t.Errorf("missing warning %q", warning)
+		}
+	}
+	if warnings["TYPE_QUICKPLAY (0x10000): missing !system 1066"] {
+		t.Errorf("quickplay reported as missing")
+	}
+}
+
+const benchmarkCards = 12000
+const benchmarkSets = 720
+
+// 在临时目录中生成 <locale>/cards.cdb 与 strings.conf
+func createBenchmarkDatabase(b *testing.B, locale string) string {
+	dir := b.TempDir()
+	localeDir := filepath.Join(dir, locale)
+	if err := os.MkdirAll(localeDir, 0755); err != nil {
+		b.Fatal(err)
+	}
+	var stringsFile strings.Builder
+	for code := 1; code <= benchmarkSets; code++ {
+		fmt.Fprintf(&stringsFile, "!setname 0x%x Set %v\n", code, code)
+	}
+	if err := ioutil.WriteFile(filepath.Join(localeDir, "strings.conf"), []byte(stringsFile.String()), 0644); err != nil {
+		b.Fatal(err)
+	}
+	db, err := sql.Open("sqlite3", filepath.Join(localeDir, "cards.cdb"))
+	if err != nil {
+		b.Fatal(err)
+	}
+	defer db.Close()
+	if _, err := db.Exec(CREATE_CDB_SQL); err != nil {
+		b.Fatal(err)
+	}
+	tx, err := db.Begin()
+	if err != nil {
+		b.Fatal(err)
+	}
+	for i := 0; i < benchmarkCards; i++ {
+		id := 10000000 + i
+		setcode := int64(i%benchmarkSets + 1)
+		if i%3 == 0 {
+			setcode |= int64((i+7)%benchmarkSets+1) << 16
+		}
+		tx.Exec("insert into datas (id, setcode, type) values (?, ?, ?)", id, setcode, int64(TYPE_MONSTER))
+		tx.Exec("insert into texts (id, name) values (?, ?)", id, fmt.Sprintf("Card %v", i))
+	}
+	if err := tx.Commit(); err != nil {
+		b.Fatal(err)
+	}
+	return dir
+}
+
+// 修改前的做法：每个系列在每个数据库上各查询一次
+func linkSetsPerQuery(environment *Environment) {
+	for i := range environment.Sets {
+		set := &environment.Sets[i]
+		set.Ids = nil
+		query := QUERY_SET_SQL
+		if set.Code > 0xfff {
+			query = QUERY_SUBSET_SQL
+		}
+		for _, db := range environment.dbs {
+			rows, err := db.Query(query, set.Code, set.Code<<16, set.Code<<32, set.Code<<48)
+			if err != nil {
+				continue
+			}
+			for rows.Next() {
+				var id int
+				rows.Scan(&id)
+				set.Ids = append(set.Ids, id)
+			}
+			rows.Close()
+		}
+	}
+}
+
+func BenchmarkNewEnvironmentPerSetQuery(b *testing.B) {
+	options := EnvironmentOptions{DatabasePath: createBenchmarkDatabase(b, "bench"), LazySets: true}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		environment := newEnvironment("bench", options)
+		linkSetsPerQuery(environment)
+		environment.Close()
+	}
+}
+
+func BenchmarkNewEnvironment(b *testing.B) {
+	options := EnvironmentOptions{DatabasePath: createBenchmarkDatabase(b, "bench")}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		environment := newEnvironment("bench", options)
+		environment.Close()
+	}
+}
@@ -0,0 +1,171 @@
+package ygopro_data
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type ExportFormat string
+
+const (
+	EXPORT_JSON   ExportFormat = "json"
+	EXPORT_NDJSON ExportFormat = "ndjson"
+	EXPORT_CSV    ExportFormat = "csv"
+)
+
+// 导出的卡片记录；不适用的数值为 null（CSV 中为空）
+type ExportedCard struct {
+	Id          int      `json:"id"`
+	Alias       int      `json:"alias"`
+	Name        string   `json:"name"`
+	Desc        string   `json:"desc"`
+	Ot          []string `json:"ot"`
+	Kind        string   `json:"kind"`
+	Types       []string `json:"types"`
+	Race        []string `json:"race"`
+	Attribute   []string `json:"attribute"`
+	Atk         *int     `json:"atk"`
+	Def         *int     `json:"def"`
+	Level       *int     `json:"level"`
+	Rank        *int     `json:"rank"`
+	LinkRating  *int     `json:"link_rating"`
+	LeftScale   *int     `json:"left_scale"`
+	RightScale  *int     `json:"right_scale"`
+	LinkMarkers []string `json:"link_markers"`
+	SetCodes    []string `json:"setcodes"`
+	SetNames    []string `json:"setnames"`
+	Categories  []string `json:"categories"`
+	Source      string   `json:"source"`
+}
+
+var exportHeader = []string{"id", "alias", "name", "desc", "ot", "kind", "types", "race", "attribute", "atk", "def",
+	"level", "rank", "link_rating", "left_scale", "right_scale", "link_markers", "setcodes", "setnames", "categories", "source"}
+
+// 导出环境中的所有卡片（按 id 排序），尚未调用 LoadAllCards 时先读取全部卡片
+func (environment *Environment) Export(writer io.Writer, format ExportFormat) error {
+	if !environment.cardsLoaded {
+		environment.LoadAllCards()
+	}
+	ids := make([]int, 0, len(environment.Cards))
+	for id := range environment.Cards {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	cards := make([]ExportedCard, len(ids))
+	for i, id := range ids {
+		card := environment.Cards[id]
+		cards[i] = environment.exportCard(&card)
+	}
+	switch format {
+	case EXPORT_JSON:
+		encoder := json.NewEncoder(writer)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(cards)
+	case EXPORT_NDJSON:
+		encoder := json.NewEncoder(writer)
+		for _, card := range cards {
+			if err := encoder.Encode(card); err != nil {
+				return err
+			}
+		}
+		return nil
+	case EXPORT_CSV:
+		return exportCsv(writer, cards)
+	default:
+		return fmt.Errorf("unknown export format %v", format)
+	}
+}
+
+func (environment *Environment) exportCard(card *Card) ExportedCard {
+	exported := ExportedCard{
+		Id:          card.Id,
+		Alias:       card.Alias,
+		Name:        card.Name,
+		Desc:        card.Desc,
+		Kind:        environment.TypeKind(card.CardType()),
+		Types:       flagNames(int64(card.Type), cardTypeNames, "TYPE_"),
+		Race:        flagNames(int64(card.Race), raceNames, "RACE_"),
+		Attribute:   flagNames(int64(card.Attribute), attributeNames, "ATTRIBUTE_"),
+		Level:       optionalInt(card.Level()),
+		Rank:        optionalInt(card.Rank()),
+		LinkRating:  optionalInt(card.LinkRating()),
+		LeftScale:   optionalInt(card.LeftScale()),
+		RightScale:  optionalInt(card.RightScale()),
+		LinkMarkers: card.LinkMarker().Names(),
+		Source:      card.Source,
+	}
+	if card.IsOcg() {
+		exported.Ot = append(exported.Ot, "ocg")
+	}
+	if card.IsTcg() {
+		exported.Ot = append(exported.Ot, "tcg")
+	}
+	if card.HasType(TYPE_MONSTER) {
+		exported.Atk = optionalInt(card.Atk)
+		if !card.HasType(TYPE_LINK) {
+			exported.Def = optionalInt(card.Def)
+		}
+	}
+	for _, code := range card.SetCodes() {
+		exported.SetCodes = append(exported.SetCodes, fmt.Sprintf("0x%x", code))
+	}
+	for _, set := range card.Sets(environment) {
+		exported.SetNames = append(exported.SetNames, set.Name)
+	}
+	exported.Categories = flagNames(int64(card.Category), cardCategoryNames, "CARD_CATEGORY_")
+	return exported
+}
+
+// 按生成的常量表列出值中包含的单个位，名称去掉前缀并转为小写
+func flagNames(value int64, names []constantName, prefix string) []string {
+	var answer []string
+	for _, constant := range names {
+		if constant.value > 0 && constant.value&(constant.value-1) == 0 && value&constant.value > 0 {
+			answer = append(answer, strings.ToLower(strings.TrimPrefix(constant.name, prefix)))
+		}
+	}
+	return answer
+}
+
+// 攻击力/守备力为 ? 时数据库中为 -2，仍然导出
+func optionalInt(value int) *int {
+	if value == -1 {
+		return nil
+	}
+	return &value
+}
+
+func exportCsv(writer io.Writer, cards []ExportedCard) error {
+	csvWriter := csv.NewWriter(writer)
+	if err := csvWriter.Write(exportHeader); err != nil {
+		return err
+	}
+	for _, card := range cards {
+		record := []string{
+			strconv.Itoa(card.Id), strconv.Itoa(card.Alias), card.Name, card.Desc,
+			strings.Join(card.Ot, "|"), card.Kind, strings.Join(card.Types, "|"),
+			strings.Join(card.Race, "|"), strings.Join(card.Attribute, "|"),
+			csvInt(card.Atk), csvInt(card.Def), csvInt(card.Level), csvInt(card.Rank),
+			csvInt(card.LinkRating), csvInt(card.LeftScale), csvInt(card.RightScale),
+			strings.Join(card.LinkMarkers, "|"), strings.Join(card.SetCodes, "|"),
+			strings.Join(card.SetNames, "|"), strings.Join(card.Categories, "|"), card.Source,
+		}
+		if err := csvWriter.Write(record); err != nil {
+			return err
+		}
+	}
+	csvWriter.Flush()
+	return csvWriter.Error()
+}
+
+func csvInt(value *int) string {
+	if value == nil {
+		return ""
+	}
+	return strconv.Itoa(*value)
+}
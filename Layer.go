@@ -0,0 +1,267 @@
+package ygopro_data
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/mattn/go-sqlite3"
+)
+
+// 卡片数据库层
+//
+// 相同 id 的卡片以优先级高的层为准；优先级相同时，后加入的层优先。
+// 语言目录中的 cdb 按文件名（不区分大小写）归类：cards.cdb 为基础层，
+// 以 prereleaseCdbPrefixes 开头的为先行卡层，以 customCdbPrefixes 开头的为自定义层，
+// 其余为官方更新层；EnvironmentOptions.ExtraCdbs 为自定义层。
+type Layer struct {
+	Name     string
+	Path     string
+	Priority int
+	Schema   int // CDB_SCHEMA_*
+
+	db        *sql.DB
+	selectSql string
+	// 层中所有卡片的 id，用于判断卡片是否被更高优先级的层覆盖
+	ids map[int]bool
+	// 通过 AddLayer 加入，Reload 时需要重新加入
+	addedAtRuntime bool
+}
+
+const (
+	LAYER_PRIORITY_BASE       = 0   // 基础卡片数据库
+	LAYER_PRIORITY_OFFICIAL   = 100 // 官方更新的扩展包
+	LAYER_PRIORITY_PRERELEASE = 200 // 先行卡
+	LAYER_PRIORITY_CUSTOM     = 300 // 服务器自定义卡片
+)
+
+func layerName(filePath string) string {
+	return strings.TrimSuffix(path.Base(strings.Replace(filePath, "\\", "/", -1)), ".cdb")
+}
+
+var prereleaseCdbPrefixes = []string{"prerelease", "pre-release", "pre_release"}
+var customCdbPrefixes = []string{"custom"}
+
+func hasAnyPrefix(name string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func classifyCdb(filePath string) int {
+	name := strings.ToLower(layerName(filePath))
+	switch {
+	case name == "cards":
+		return LAYER_PRIORITY_BASE
+	case hasAnyPrefix(name, prereleaseCdbPrefixes):
+		return LAYER_PRIORITY_PRERELEASE
+	case hasAnyPrefix(name, customCdbPrefixes):
+		return LAYER_PRIORITY_CUSTOM
+	default:
+		return LAYER_PRIORITY_OFFICIAL
+	}
+}
+
+// 文件系统中的 cdb 读入内存后反序列化为内存数据库，不写入磁盘
+func (environment *Environment) openLayer(name string, filePath string, priority int) (*Layer, error) {
+	layer := &Layer{Name: name, Path: filePath, Priority: priority}
+	if environment.options.FS == nil {
+		// 只读打开，文件不存在时不会创建空的 cdb
+		if _, err := environment.options.stat(filePath); err != nil {
+			return nil, err
+		}
+		db, err := sql.Open("sqlite3", readOnlyUri(filePath))
+		if err != nil {
+			return nil, err
+		}
+		layer.db = db
+		return layer, layer.prepare()
+	}
+	bytes, err := environment.options.readFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+	if layer.db, err = openMemoryDb(bytes); err != nil {
+		return nil, err
+	}
+	return layer, layer.prepare()
+}
+
+// 每个连接都有各自的内存数据库，因此只保留一个连接；
+// 查询时需要先关闭之前的 rows，否则会一直等待连接
+func openMemoryDb(bytes []byte) (*sql.DB, error) {
+	db, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		return nil, err
+	}
+	db.SetMaxOpenConns(1)
+	db.SetMaxIdleConns(1)
+	conn, err := db.Conn(context.Background())
+	if err == nil {
+		err = conn.Raw(func(driverConn interface{}) error {
+			return driverConn.(*sqlite3.SQLiteConn).Deserialize(bytes, "main")
+		})
+		conn.Close()
+	}
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	return db, nil
+}
+
+func (layer *Layer) close() error {
+	if layer.db == nil {
+		return nil
+	}
+	return layer.db.Close()
+}
+
+// 检测结构版本并读取所有 id，出错时关闭层
+func (layer *Layer) prepare() error {
+	version, selectSql, err := detectSchema(layer.db)
+	if err == nil && version == CDB_SCHEMA_INVALID {
+		err = fmt.Errorf("%v: not a card database", layer.Path)
+	}
+	if err == nil {
+		layer.ids, err = readIds(layer.db)
+	}
+	if err != nil {
+		layer.close()
+		return err
+	}
+	layer.Schema = version
+	layer.selectSql = selectSql
+	return nil
+}
+
+func readIds(db *sql.DB) (map[int]bool, error) {
+	rows, err := db.Query(READ_ALL_ID_SQL)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	ids := make(map[int]bool)
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids[id] = true
+	}
+	return ids, rows.Err()
+}
+
+// 第 index 层中的卡片是否被更高优先级的层覆盖
+func (environment *Environment) shadowed(id int, index int) bool {
+	for _, layer := range environment.layers[index+1:] {
+		if layer.ids[id] {
+			return true
+		}
+	}
+	return false
+}
+
+// 按优先级从高到低在各层执行查询（第一列为卡片 id），
+// 被更高优先级的层覆盖的卡片不计入，结果去重并排序
+func (environment *Environment) queryIds(query string, args ...interface{}) []int {
+	var ids []int
+	for i := len(environment.layers) - 1; i >= 0; i-- {
+		rows, err := environment.layers[i].db.Query(query, args...)
+		if err != nil {
+			continue
+		}
+		for rows.Next() {
+			var id int
+			if rows.Scan(&id) == nil && !environment.shadowed(id, i) {
+				ids = append(ids, id)
+			}
+		}
+		rows.Close()
+	}
+	return uniqueSortedIds(ids)
+}
+
+// 按优先级从低到高保存
+func (environment *Environment) insertLayer(layer *Layer) {
+	index := len(environment.layers)
+	for index > 0 && environment.layers[index-1].Priority > layer.Priority {
+		index--
+	}
+	environment.layers = append(environment.layers, nil)
+	copy(environment.layers[index+1:], environment.layers[index:])
+	environment.layers[index] = layer
+}
+
+// dbs 按优先级从高到低排列，遍历时先命中的即为生效的卡片
+func (environment *Environment) rebuildDbs() {
+	environment.dbs = make([]*sql.DB, 0, len(environment.layers))
+	for i := len(environment.layers) - 1; i >= 0; i-- {
+		environment.dbs = append(environment.dbs, environment.layers[i].db)
+	}
+}
+
+// 层变化后卡片缓存与系列成员都需要重新计算
+func (environment *Environment) layersChanged() {
+	environment.rebuildDbs()
+	environment.Cards = make(map[int]Card)
+	environment.cardsLoaded = false
+	environment.setsLock.Lock()
+	if environment.setsLinked {
+		environment.linkSets()
+	}
+	environment.setsLock.Unlock()
+}
+
+// 按优先级从低到高返回当前的层
+func (environment *Environment) Layers() []Layer {
+	layers := make([]Layer, len(environment.layers))
+	for i, layer := range environment.layers {
+		layers[i] = *layer
+	}
+	return layers
+}
+
+// 层的副本，供 Watcher 等其他 goroutine 读取
+func (environment *Environment) layerSnapshot() []*Layer {
+	environment.layersLock.RLock()
+	defer environment.layersLock.RUnlock()
+	return append([]*Layer(nil), environment.layers...)
+}
+
+func (environment *Environment) AddLayer(name string, filePath string, priority int) error {
+	for _, layer := range environment.layerSnapshot() {
+		if layer.Name == name {
+			return fmt.Errorf("layer %v already exists", name)
+		}
+	}
+	layer, err := environment.openLayer(name, filePath, priority)
+	if err != nil {
+		return err
+	}
+	layer.addedAtRuntime = true
+	environment.layersLock.Lock()
+	environment.insertLayer(layer)
+	environment.layersLock.Unlock()
+	environment.layersChanged()
+	return nil
+}
+
+func (environment *Environment) RemoveLayer(name string) error {
+	environment.layersLock.Lock()
+	for i, layer := range environment.layers {
+		if layer.Name == name {
+			environment.layers = append(environment.layers[:i], environment.layers[i+1:]...)
+			environment.layersLock.Unlock()
+			environment.layersChanged()
+			return layer.close()
+		}
+	}
+	environment.layersLock.Unlock()
+	return fmt.Errorf("layer %v not found", name)
+}
@@ -0,0 +1,153 @@
+package ygopro_data
+
+import (
+	"math/bits"
+	"strings"
+)
+
+// 连接标记，连接怪兽的 Def 字段按位保存；0x10 是中心位，没有意义
+type LinkMarker int
+
+const (
+	LINK_MARKER_BOTTOM_LEFT  LinkMarker = 0x001 // ↙
+	LINK_MARKER_BOTTOM       LinkMarker = 0x002 // ↓
+	LINK_MARKER_BOTTOM_RIGHT LinkMarker = 0x004 // ↘
+	LINK_MARKER_LEFT         LinkMarker = 0x008 // ←
+	LINK_MARKER_RIGHT        LinkMarker = 0x020 // →
+	LINK_MARKER_TOP_LEFT     LinkMarker = 0x040 // ↖
+	LINK_MARKER_TOP          LinkMarker = 0x080 // ↑
+	LINK_MARKER_TOP_RIGHT    LinkMarker = 0x100 // ↗
+
+	LINK_MARKER_ALL LinkMarker = 0x1ef
+)
+
+type linkDirection struct {
+	marker LinkMarker
+	name   string
+	arrow  string
+	dx, dy int
+}
+
+// 按阅读顺序（从上到下、从左到右）排列
+var linkDirections = []linkDirection{
+	{LINK_MARKER_TOP_LEFT, "top_left", "↖", -1, 1},
+	{LINK_MARKER_TOP, "top", "↑", 0, 1},
+	{LINK_MARKER_TOP_RIGHT, "top_right", "↗", 1, 1},
+	{LINK_MARKER_LEFT, "left", "←", -1, 0},
+	{LINK_MARKER_RIGHT, "right", "→", 1, 0},
+	{LINK_MARKER_BOTTOM_LEFT, "bottom_left", "↙", -1, -1},
+	{LINK_MARKER_BOTTOM, "bottom", "↓", 0, -1},
+	{LINK_MARKER_BOTTOM_RIGHT, "bottom_right", "↘", 1, -1},
+}
+
+func (card *Card) LinkMarker() LinkMarker {
+	if !card.CardType().IsLink() {
+		return 0
+	}
+	return LinkMarker(card.Def) & LINK_MARKER_ALL
+}
+
+func (marker LinkMarker) Has(direction LinkMarker) bool {
+	return marker&direction == direction
+}
+
+// 标记数量，对合法的连接怪兽应当等于 LinkNumber()
+func (marker LinkMarker) Count() int {
+	return bits.OnesCount(uint(marker & LINK_MARKER_ALL))
+}
+
+func (marker LinkMarker) String() string {
+	var arrows []string
+	for _, direction := range linkDirections {
+		if marker.Has(direction.marker) {
+			arrows = append(arrows, direction.arrow)
+		}
+	}
+	return strings.Join(arrows, "")
+}
+
+// 标记方向的名称，如 top_left
+func (marker LinkMarker) Names() []string {
+	var names []string
+	for _, direction := range linkDirections {
+		if marker.Has(direction.marker) {
+			names = append(names, direction.name)
+		}
+	}
+	return names
+}
+
+// 以 3x3 方格绘制标记，没有标记的方向用 · 表示
+func (marker LinkMarker) Grid() string {
+	cell := func(index int) string {
+		if direction := linkDirections[index]; marker.Has(direction.marker) {
+			return direction.arrow
+		}
+		return "·"
+	}
+	return cell(0) + cell(1) + cell(2) + "\n" +
+		cell(3) + "□" + cell(4) + "\n" +
+		cell(5) + cell(6) + cell(7)
+}
+
+// 场上的怪兽区域；Player 为 0 表示自己，1 表示对方
+// Sequence 0-4 为主要怪兽区，5、6 为额外怪兽区
+type FieldZone struct {
+	Player, Sequence int
+}
+
+// 新大师规则下，位于自己 sequence 号怪兽区的连接怪兽所指向的区域
+// 指向魔陷区或场外的标记不计入。额外怪兽区由双方共用，自己的 5 号区即对方的 6 号区，
+// 指向额外怪兽区时两者都会返回，与游戏核心的 get_linked_zone 一致
+func (marker LinkMarker) LinkedZones(sequence int) []FieldZone {
+	x, y, ok := zonePosition(sequence)
+	if !ok {
+		return nil
+	}
+	var zones []FieldZone
+	for _, direction := range linkDirections {
+		if !marker.Has(direction.marker) {
+			continue
+		}
+		if zone, exist := positionZone(x+direction.dx, y+direction.dy); exist {
+			zones = append(zones, zone)
+			if zone.Sequence >= 5 {
+				zones = append(zones, FieldZone{1, 11 - zone.Sequence})
+			}
+		}
+	}
+	return zones
+}
+
+// 以自己的视角把区域映射到坐标：自己的主要怪兽区在第 0 行，
+// 额外怪兽区在第 1 行的第 1、3 列，对方的主要怪兽区在第 2 行且左右颠倒
+func zonePosition(sequence int) (x, y int, ok bool) {
+	switch {
+	case sequence >= 0 && sequence < 5:
+		return sequence, 0, true
+	case sequence == 5:
+		return 1, 1, true
+	case sequence == 6:
+		return 3, 1, true
+	default:
+		return 0, 0, false
+	}
+}
+
+func positionZone(x, y int) (FieldZone, bool) {
+	if x < 0 || x > 4 {
+		return FieldZone{}, false
+	}
+	switch {
+	case y == 0:
+		return FieldZone{0, x}, true
+	case y == 1 && x == 1:
+		return FieldZone{0, 5}, true
+	case y == 1 && x == 3:
+		return FieldZone{0, 6}, true
+	case y == 2:
+		return FieldZone{1, 4 - x}, true
+	default:
+		return FieldZone{}, false
+	}
+}
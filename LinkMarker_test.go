@@ -0,0 +1,48 @@
+package ygopro_data
+
+import "testing"
+
+// 以游戏核心的区域掩码表示：自己的 n 号怪兽区为 1<<n，对方的为 1<<(16+n)
+func zoneMask(zones []FieldZone) uint32 {
+	var mask uint32
+	for _, zone := range zones {
+		mask |= 1 << uint(zone.Player*16+zone.Sequence)
+	}
+	return mask
+}
+
+func TestLinkedZones(t *testing.T) {
+	tests := []struct {
+		sequence int
+		marker   LinkMarker
+		mask     uint32
+	}{
+		{0, LINK_MARKER_TOP_RIGHT, 1<<5 | 1<<(16+6)},
+		{1, LINK_MARKER_TOP, 1<<5 | 1<<(16+6)},
+		{2, LINK_MARKER_TOP_LEFT, 1<<5 | 1<<(16+6)},
+		{2, LINK_MARKER_TOP_RIGHT, 1<<6 | 1<<(16+5)},
+		{3, LINK_MARKER_TOP, 1<<6 | 1<<(16+5)},
+		{4, LINK_MARKER_TOP_LEFT, 1<<6 | 1<<(16+5)},
+		{2, LINK_MARKER_TOP_LEFT | LINK_MARKER_TOP_RIGHT, 1<<5 | 1<<6 | 1<<(16+5) | 1<<(16+6)},
+		{0, LINK_MARKER_TOP | LINK_MARKER_TOP_LEFT | LINK_MARKER_LEFT, 0},
+		{0, LINK_MARKER_RIGHT, 1 << 1},
+		{4, LINK_MARKER_LEFT | LINK_MARKER_RIGHT, 1 << 3},
+		{2, LINK_MARKER_LEFT | LINK_MARKER_RIGHT | LINK_MARKER_BOTTOM, 1<<1 | 1<<3},
+		{1, LINK_MARKER_TOP_LEFT | LINK_MARKER_TOP_RIGHT, 0},
+		{5, LINK_MARKER_BOTTOM_LEFT, 1 << 0},
+		{5, LINK_MARKER_BOTTOM, 1 << 1},
+		{5, LINK_MARKER_BOTTOM_RIGHT, 1 << 2},
+		{5, LINK_MARKER_TOP_LEFT, 1 << (16 + 4)},
+		{5, LINK_MARKER_TOP, 1 << (16 + 3)},
+		{5, LINK_MARKER_TOP_RIGHT, 1 << (16 + 2)},
+		{5, LINK_MARKER_LEFT | LINK_MARKER_RIGHT, 0},
+		{6, LINK_MARKER_BOTTOM_LEFT | LINK_MARKER_BOTTOM | LINK_MARKER_BOTTOM_RIGHT, 1<<2 | 1<<3 | 1<<4},
+		{6, LINK_MARKER_TOP_LEFT | LINK_MARKER_TOP | LINK_MARKER_TOP_RIGHT, 1<<(16+2) | 1<<(16+1) | 1<<(16+0)},
+		{7, LINK_MARKER_ALL, 0},
+	}
+	for _, test := range tests {
+		if got := zoneMask(test.marker.LinkedZones(test.sequence)); got != test.mask {
+			t.Errorf("sequence %v marker %v: mask 0x%x, want 0x%x", test.sequence, test.marker, got, test.mask)
+		}
+	}
+}
@@ -0,0 +1,177 @@
+package ygopro_data
+
+import (
+	"sync"
+	"time"
+)
+
+// 重新读取 strings.conf 与所有数据库层，生成新的环境并替换 Environments 中的旧环境，
+// 运行时通过 AddLayer 加入的层会保留下来。
+// 已经持有旧环境的调用方继续使用旧的快照；旧环境不会自动关闭，不再使用后由调用方 Close。
+// 旧环境已被 RemoveEnvironment 移除或已被替换时，新环境不会放入 Environments。
+func (environment *Environment) Reload() (*Environment, error) {
+	fresh := newEnvironment(environment.Locale, environment.options)
+	var answer error
+	for _, layer := range environment.layerSnapshot() {
+		if !layer.addedAtRuntime {
+			continue
+		}
+		if err := fresh.AddLayer(layer.Name, layer.Path, layer.Priority); err != nil && answer == nil {
+			answer = err
+		}
+	}
+	environmentsLock.Lock()
+	if current, has := Environments[environment.Locale]; has && current == environment {
+		Environments[environment.Locale] = fresh
+	}
+	environmentsLock.Unlock()
+	return fresh, answer
+}
+
+// Watcher 替换环境后，等待这段时间再关闭旧环境，让仍在使用旧环境的调用方完成查询
+const WATCHER_CLOSE_DELAY = time.Minute
+
+// 监视语言环境用到的 strings.conf 与 cdb 文件，发生变化时调用 Reload
+//
+// Interval 大于 0 时按该间隔轮询文件的修改时间和大小；
+// 也可以在收到 fsnotify 等文件事件时调用 Notify 立即检查。
+// 被替换的旧环境在 WATCHER_CLOSE_DELAY 之后关闭，调用方应及时改用 GetEnvironment 取得的新环境。
+// 所监视的环境被 RemoveEnvironment 移除或被其他调用方替换后，Watcher 自动停止。
+type Watcher struct {
+	Locale   string
+	Interval time.Duration
+
+	// 每次重新加载后的新环境与错误，缓冲区满时丢弃
+	Reloaded chan *Environment
+	Errors   chan error
+
+	environment *Environment
+	stamps      map[string]fileStamp
+	notify      chan struct{}
+	stop        chan struct{}
+	stopOnce    sync.Once
+}
+
+type fileStamp struct {
+	modTime time.Time
+	size    int64
+	exist   bool
+}
+
+func WatchEnvironment(locale string, interval time.Duration) *Watcher {
+	watcher := &Watcher{
+		Locale:   locale,
+		Interval: interval,
+		Reloaded: make(chan *Environment, 1),
+		Errors:   make(chan error, 1),
+		notify:   make(chan struct{}, 1),
+		stop:     make(chan struct{}),
+	}
+	watcher.environment = GetEnvironment(locale)
+	watcher.stamps = watcher.environment.fileStamps()
+	go watcher.run()
+	return watcher
+}
+
+func (watcher *Watcher) Notify() {
+	select {
+	case watcher.notify <- struct{}{}:
+	default:
+	}
+}
+
+func (watcher *Watcher) Stop() {
+	watcher.stopOnce.Do(func() {
+		close(watcher.stop)
+	})
+}
+
+func (watcher *Watcher) run() {
+	var tick <-chan time.Time
+	if watcher.Interval > 0 {
+		ticker := time.NewTicker(watcher.Interval)
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+	for {
+		select {
+		case <-watcher.stop:
+			return
+		case <-tick:
+		case <-watcher.notify:
+		}
+		watcher.check()
+	}
+}
+
+func (watcher *Watcher) check() {
+	environment := watcher.environment
+	if !environment.registered() {
+		watcher.Stop()
+		return
+	}
+	stamps := environment.fileStamps()
+	if sameStamps(stamps, watcher.stamps) {
+		return
+	}
+	fresh, err := environment.Reload()
+	if !fresh.registered() {
+		fresh.Close()
+		watcher.Stop()
+		return
+	}
+	watcher.environment = fresh
+	watcher.stamps = fresh.fileStamps()
+	time.AfterFunc(WATCHER_CLOSE_DELAY, func() {
+		environment.Close()
+	})
+	if err != nil {
+		select {
+		case watcher.Errors <- err:
+		default:
+		}
+	}
+	select {
+	case watcher.Reloaded <- fresh:
+	default:
+	}
+}
+
+// 是否仍是 Environments 中该语言的环境
+func (environment *Environment) registered() bool {
+	environmentsLock.RLock()
+	defer environmentsLock.RUnlock()
+	return Environments[environment.Locale] == environment
+}
+
+func (environment *Environment) fileStamps() map[string]fileStamp {
+	options := environment.options
+	paths := []string{options.join(options.databasePath(), environment.Locale, "strings.conf")}
+	if matches, err := options.glob(options.join(options.databasePath(), environment.Locale, "*.cdb")); err == nil {
+		paths = append(paths, matches...)
+	}
+	for _, layer := range environment.layerSnapshot() {
+		paths = append(paths, layer.Path)
+	}
+	stamps := make(map[string]fileStamp)
+	for _, path := range paths {
+		if info, err := options.stat(path); err == nil {
+			stamps[path] = fileStamp{info.ModTime(), info.Size(), true}
+		} else {
+			stamps[path] = fileStamp{}
+		}
+	}
+	return stamps
+}
+
+func sameStamps(left map[string]fileStamp, right map[string]fileStamp) bool {
+	if len(left) != len(right) {
+		return false
+	}
+	for path, stamp := range left {
+		if other, exist := right[path]; !exist || !other.modTime.Equal(stamp.modTime) || other.size != stamp.size || other.exist != stamp.exist {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,75 @@
+package ygopro_data
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+)
+
+// cdb 的结构版本，打开层时检测
+const (
+	CDB_SCHEMA_INVALID  = iota // 缺少 datas、texts 表或 id 列
+	CDB_SCHEMA_LEGACY          // 缺少部分标准列，如早期没有 category 列的 cdb，缺少的列按 0 或空字符串读取
+	CDB_SCHEMA_STANDARD        // 与 ygopro 的标准结构一致
+	CDB_SCHEMA_EXTENDED        // 包含全部标准列，另有额外的列
+)
+
+// 按 createCardFromData 的读取顺序排列
+var datasColumns = []string{"ot", "alias", "setcode", "type", "atk", "def", "level", "race", "attribute", "category"}
+var textsColumns = []string{"name", "desc", "str1", "str2", "str3", "str4", "str5", "str6", "str7", "str8",
+	"str9", "str10", "str11", "str12", "str13", "str14", "str15", "str16"}
+
+func tableColumns(db *sql.DB, table string) (map[string]bool, error) {
+	rows, err := db.Query("select name from pragma_table_info(?)", table)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	columns := make(map[string]bool)
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		columns[strings.ToLower(name)] = true
+	}
+	return columns, rows.Err()
+}
+
+// 检测结构版本，并生成只选择已知列的查询语句；缺少的列以常量代替，NULL 按 0 或空字符串读取
+func detectSchema(db *sql.DB) (version int, selectSql string, err error) {
+	datas, err := tableColumns(db, "datas")
+	if err != nil {
+		return CDB_SCHEMA_INVALID, "", err
+	}
+	texts, err := tableColumns(db, "texts")
+	if err != nil {
+		return CDB_SCHEMA_INVALID, "", err
+	}
+	if !datas["id"] || !texts["id"] {
+		return CDB_SCHEMA_INVALID, "", nil
+	}
+	version = CDB_SCHEMA_STANDARD
+	selected := []string{"datas.id"}
+	for _, column := range datasColumns {
+		if datas[column] {
+			selected = append(selected, fmt.Sprintf("ifnull(datas.%v, 0)", column))
+		} else {
+			selected = append(selected, "0")
+			version = CDB_SCHEMA_LEGACY
+		}
+	}
+	for _, column := range textsColumns {
+		if texts[column] {
+			selected = append(selected, fmt.Sprintf("ifnull(texts.%v, '')", column))
+		} else {
+			selected = append(selected, "''")
+			version = CDB_SCHEMA_LEGACY
+		}
+	}
+	if version == CDB_SCHEMA_STANDARD && (len(datas) > len(datasColumns)+1 || len(texts) > len(textsColumns)+1) {
+		version = CDB_SCHEMA_EXTENDED
+	}
+	selectSql = "select " + strings.Join(selected, ", ") + " from datas join texts on datas.id == texts.id"
+	return version, selectSql, nil
+}
@@ -0,0 +1,148 @@
+package ygopro_data
+
+import (
+	"errors"
+	"regexp"
+	"sort"
+	"strconv"
+)
+
+// 卡片脚本 c<id>.lua 的源码；没有自己脚本的同名卡使用原卡的脚本
+func (card *Card) Script() (string, error) {
+	environment := GetEnvironment(card.Locale)
+	source, err := environment.Script(card.Id)
+	if err != nil && card.IsAlias() {
+		return environment.Script(card.Alias)
+	}
+	return source, err
+}
+
+func (environment *Environment) Script(id int) (string, error) {
+	options := environment.options
+	if len(options.scriptPath()) == 0 {
+		return "", errors.New("script path is not configured")
+	}
+	bytes, err := options.readFile(options.join(options.scriptPath(), "c"+strconv.Itoa(id)+".lua"))
+	if err != nil {
+		return "", err
+	}
+	return string(bytes), nil
+}
+
+// 脚本的静态分析结果，各项去重并排序
+type ScriptInfo struct {
+	Effects     []EffectCode
+	EffectTypes []EffectType
+	EffectFlags []EffectFlag
+	// EFFECT_FLAG2_ 开头的常量
+	EffectFlags2 []EffectFlag2
+	CountCodes   []CountCode
+	Events       []Event
+	Categories   []Category
+	// 脚本中出现的其他卡片 id 与系列代码
+	CardIds  []int
+	SetCodes []int64
+}
+
+var scriptConstantReg = regexp.MustCompile(`\b(?:EFFECT|EVENT|CATEGORY)_[A-Z0-9_]+\b`)
+var scriptNumberReg = regexp.MustCompile(`\b\d{5,9}\b`)
+var scriptSetCodeReg = regexp.MustCompile(`SetCard\(\s*(0x[0-9a-fA-F]+|\d+)`)
+
+var scriptEffects = constantIndex(effectCodeNames)
+var scriptEffectTypes = constantIndex(effectTypeNames)
+var scriptEffectFlags = constantIndex(effectFlagNames)
+var scriptEffectFlags2 = constantIndex(effectFlag2Names)
+var scriptCountCodes = constantIndex(countCodeNames)
+var scriptEvents = constantIndex(eventNames)
+var scriptCategories = constantIndex(categoryNames)
+
+func constantIndex(names []constantName) map[string]int64 {
+	index := make(map[string]int64)
+	for _, constant := range names {
+		index[constant.name] = constant.value
+	}
+	return index
+}
+
+// 数字字面量只要是 5 到 9 位就视为卡片 id，结果可能包含攻击力之类的数值，
+// 需要准确结果时使用 Card.ScriptInfo，它会排除环境中不存在的 id
+func AnalyzeScript(source string) ScriptInfo {
+	var info ScriptInfo
+	source = stripLuaComments(source)
+	seen := make(map[string]bool)
+	for _, name := range scriptConstantReg.FindAllString(source, -1) {
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+		if value, exist := scriptEffects[name]; exist {
+			info.Effects = append(info.Effects, EffectCode(value))
+		} else if value, exist := scriptEffectTypes[name]; exist {
+			info.EffectTypes = append(info.EffectTypes, EffectType(value))
+		} else if value, exist := scriptEffectFlags[name]; exist {
+			info.EffectFlags = append(info.EffectFlags, EffectFlag(value))
+		} else if value, exist := scriptEffectFlags2[name]; exist {
+			info.EffectFlags2 = append(info.EffectFlags2, EffectFlag2(value))
+		} else if value, exist := scriptCountCodes[name]; exist {
+			info.CountCodes = append(info.CountCodes, CountCode(value))
+		} else if value, exist := scriptEvents[name]; exist {
+			info.Events = append(info.Events, Event(value))
+		} else if value, exist := scriptCategories[name]; exist {
+			info.Categories = append(info.Categories, Category(value))
+		}
+	}
+	sort.Slice(info.Effects, func(i, j int) bool { return info.Effects[i] < info.Effects[j] })
+	sort.Slice(info.EffectTypes, func(i, j int) bool { return info.EffectTypes[i] < info.EffectTypes[j] })
+	sort.Slice(info.EffectFlags, func(i, j int) bool { return info.EffectFlags[i] < info.EffectFlags[j] })
+	sort.Slice(info.EffectFlags2, func(i, j int) bool { return info.EffectFlags2[i] < info.EffectFlags2[j] })
+	sort.Slice(info.CountCodes, func(i, j int) bool { return info.CountCodes[i] < info.CountCodes[j] })
+	sort.Slice(info.Events, func(i, j int) bool { return info.Events[i] < info.Events[j] })
+	sort.Slice(info.Categories, func(i, j int) bool { return info.Categories[i] < info.Categories[j] })
+
+	var ids []int
+	for _, number := range scriptNumberReg.FindAllString(source, -1) {
+		id, _ := strconv.Atoi(number)
+		ids = append(ids, id)
+	}
+	info.CardIds = uniqueSortedIds(ids)
+
+	codes := make(map[int64]bool)
+	for _, match := range scriptSetCodeReg.FindAllStringSubmatch(source, -1) {
+		if code, err := strconv.ParseInt(match[1], 0, 64); err == nil && !codes[code] {
+			codes[code] = true
+			info.SetCodes = append(info.SetCodes, code)
+		}
+	}
+	sort.Slice(info.SetCodes, func(i, j int) bool { return info.SetCodes[i] < info.SetCodes[j] })
+	return info
+}
+
+// 分析卡片脚本，CardIds 只保留环境中存在的其他卡片
+func (card *Card) ScriptInfo() (ScriptInfo, error) {
+	source, err := card.Script()
+	if err != nil {
+		return ScriptInfo{}, err
+	}
+	info := AnalyzeScript(source)
+	environment := GetEnvironment(card.Locale)
+	ids := make([]int, 0, len(info.CardIds))
+	for _, id := range info.CardIds {
+		if id == card.Id || id == card.Alias {
+			continue
+		}
+		if _, exist := environment.GetCard(id); exist {
+			ids = append(ids, id)
+		}
+	}
+	info.CardIds = ids
+	return info, nil
+}
+
+var luaBlockCommentReg = regexp.MustCompile(`(?s)--\[=*\[.*?\]=*\]`)
+var luaLineCommentReg = regexp.MustCompile(`--[^\n]*`)
+
+// 注释中的常量和数字不计入分析结果
+func stripLuaComments(source string) string {
+	source = luaBlockCommentReg.ReplaceAllString(source, "")
+	return luaLineCommentReg.ReplaceAllString(source, "")
+}
@@ -11,10 +11,14 @@ type Set struct {
 	Code       int64
 	Ids        []int
 	OriginName string
+
+	// 子系列（如 0x1034）的父系列为低 12 位相同的系列（0x34）
+	Parent   *Set
+	Children []*Set
 }
 
 func createSet(code int64, name string, locale string) Set {
-	set := Set{locale, name, code, make([]int, 0), ""}
+	set := Set{locale, name, code, make([]int, 0), "", nil, nil}
 	set.separateOriginNameFromName()
 	return set
 }
@@ -57,4 +61,191 @@ func (set *Set) includeInSort(id int) bool {
 
 func (set *Set) Sort() {
 	sort.Ints(set.Ids)
-}
\ No newline at end of file
+}
+
+func (set *Set) IsSubSet() bool {
+	return set.Code > 0xfff
+}
+
+// 包含所有子系列成员的卡片，去重并排序
+func (set *Set) AllIds() []int {
+	hash := make(map[int]bool)
+	var ids []int
+	var collect func(*Set)
+	collect = func(current *Set) {
+		for _, id := range current.Ids {
+			if !hash[id] {
+				hash[id] = true
+				ids = append(ids, id)
+			}
+		}
+		for _, child := range current.Children {
+			collect(child)
+		}
+	}
+	collect(set)
+	sort.Ints(ids)
+	return ids
+}
+
+// 系列树
+func (environment *Environment) linkSetHierarchy() {
+	parents := make(map[int64]*Set)
+	for i := range environment.Sets {
+		set := &environment.Sets[i]
+		set.Parent = nil
+		set.Children = nil
+		if !set.IsSubSet() {
+			parents[set.Code] = set
+		}
+	}
+	for i := range environment.Sets {
+		set := &environment.Sets[i]
+		if !set.IsSubSet() {
+			continue
+		}
+		if parent, exist := parents[set.Code&0xfff]; exist {
+			set.Parent = parent
+			parent.Children = append(parent.Children, set)
+		}
+	}
+}
+
+// 返回没有父系列的顶层系列
+func (environment *Environment) SetTree() []*Set {
+	environment.LinkSets()
+	var roots []*Set
+	for i := range environment.Sets {
+		if environment.Sets[i].Parent == nil {
+			roots = append(roots, &environment.Sets[i])
+		}
+	}
+	return roots
+}
+
+// 系列索引
+func (environment *Environment) indexSets() {
+	environment.setsByCode = make(map[int64]*Set)
+	environment.setsByName = make(map[string]*Set)
+	for i := range environment.Sets {
+		set := &environment.Sets[i]
+		environment.setsByCode[set.Code] = set
+		environment.setsByName[set.Name] = set
+		if len(set.OriginName) > 0 {
+			environment.setsByName[set.OriginName] = set
+		}
+	}
+}
+
+// 扫描卡片数据库填充 Set.Ids 并建立卡片到系列的索引，只执行一次，可以并发调用
+// 使用 LazySets 时，SetByCode 等方法会自动调用；直接读取 Environment.Sets 之前需要先调用
+func (environment *Environment) LinkSets() {
+	environment.setsLock.Lock()
+	defer environment.setsLock.Unlock()
+	if !environment.setsLinked {
+		environment.linkSets()
+	}
+}
+
+// 调用方需持有 setsLock
+func (environment *Environment) linkSets() {
+	environment.linkSetNameToSQL()
+	environment.setsOfCard = make(map[int][]*Set)
+	for i := range environment.Sets {
+		set := &environment.Sets[i]
+		for _, id := range set.Ids {
+			environment.setsOfCard[id] = append(environment.setsOfCard[id], set)
+		}
+	}
+	environment.setsLinked = true
+}
+
+func (environment *Environment) SetByCode(code int64) (*Set, bool) {
+	environment.LinkSets()
+	set, exist := environment.setsByCode[code]
+	return set, exist
+}
+
+// 按本地化名称或原名查找系列
+func (environment *Environment) SetByName(name string) (*Set, bool) {
+	environment.LinkSets()
+	set, exist := environment.setsByName[name]
+	return set, exist
+}
+
+func (environment *Environment) SetsOf(id int) []*Set {
+	environment.LinkSets()
+	return environment.setsOfCard[id]
+}
+
+// 集合运算，结果按 id 排序且不含重复
+func createIdSet(locale string, name string, ids []int) Set {
+	set := Set{locale, name, 0, uniqueSortedIds(ids), "", nil, nil}
+	return set
+}
+
+func uniqueSortedIds(ids []int) []int {
+	answer := make([]int, len(ids))
+	copy(answer, ids)
+	sort.Ints(answer)
+	length := 0
+	for i, id := range answer {
+		if i == 0 || id != answer[length-1] {
+			answer[length] = id
+			length++
+		}
+	}
+	return answer[:length]
+}
+
+func (set *Set) Union(other Set) Set {
+	return createIdSet(set.Locale, set.Name+" | "+other.Name, append(append([]int{}, set.Ids...), other.Ids...))
+}
+
+func (set *Set) Intersect(other Set) Set {
+	left, right := uniqueSortedIds(set.Ids), uniqueSortedIds(other.Ids)
+	var ids []int
+	for i, j := 0, 0; i < len(left) && j < len(right); {
+		switch {
+		case left[i] < right[j]:
+			i++
+		case left[i] > right[j]:
+			j++
+		default:
+			ids = append(ids, left[i])
+			i++
+			j++
+		}
+	}
+	return createIdSet(set.Locale, set.Name+" & "+other.Name, ids)
+}
+
+func (set *Set) Difference(other Set) Set {
+	left, right := uniqueSortedIds(set.Ids), uniqueSortedIds(other.Ids)
+	var ids []int
+	j := 0
+	for _, id := range left {
+		for j < len(right) && right[j] < id {
+			j++
+		}
+		if j >= len(right) || right[j] != id {
+			ids = append(ids, id)
+		}
+	}
+	return createIdSet(set.Locale, set.Name+" - "+other.Name, ids)
+}
+
+// 相对于环境中所有卡片的补集，尚未调用 LoadAllCards 时先读取全部卡片
+func (set *Set) Complement(environment *Environment) Set {
+	if !environment.cardsLoaded {
+		environment.LoadAllCards()
+	}
+	all := make([]int, 0, len(environment.Cards))
+	for id := range environment.Cards {
+		all = append(all, id)
+	}
+	universe := createIdSet(environment.Locale, "", all)
+	complement := universe.Difference(*set)
+	complement.Name = "!" + set.Name
+	return complement
+}
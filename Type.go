@@ -0,0 +1,80 @@
+package ygopro_data
+
+import "strings"
+
+//go:generate go run ./cmd/luaconst -lua Constant.lua -out Constant.go
+
+func (card *Card) CardType() CardType {
+	return CardType(card.Type)
+}
+
+func (cardType CardType) Has(flag CardType) bool {
+	return cardType&flag > 0
+}
+
+func (cardType CardType) IsMonster() bool     { return cardType.Has(TYPE_MONSTER) }
+func (cardType CardType) IsSpell() bool       { return cardType.Has(TYPE_SPELL) }
+func (cardType CardType) IsTrap() bool        { return cardType.Has(TYPE_TRAP) }
+func (cardType CardType) IsNormal() bool      { return cardType.Has(TYPE_NORMAL) }
+func (cardType CardType) IsEffect() bool      { return cardType.Has(TYPE_EFFECT) }
+func (cardType CardType) IsFusion() bool      { return cardType.Has(TYPE_FUSION) }
+func (cardType CardType) IsRitual() bool      { return cardType.Has(TYPE_RITUAL) }
+func (cardType CardType) IsTrapMonster() bool { return cardType.Has(TYPE_TRAPMONSTER) }
+func (cardType CardType) IsSpirit() bool      { return cardType.Has(TYPE_SPIRIT) }
+func (cardType CardType) IsUnion() bool       { return cardType.Has(TYPE_UNION) }
+func (cardType CardType) IsDual() bool        { return cardType.Has(TYPE_DUAL) }
+func (cardType CardType) IsTuner() bool       { return cardType.Has(TYPE_TUNER) }
+func (cardType CardType) IsSynchro() bool     { return cardType.Has(TYPE_SYNCHRO) }
+func (cardType CardType) IsToken() bool       { return cardType.Has(TYPE_TOKEN) }
+func (cardType CardType) IsQuickPlay() bool   { return cardType.Has(TYPE_QUICKPLAY) }
+func (cardType CardType) IsContinuous() bool  { return cardType.Has(TYPE_CONTINUOUS) }
+func (cardType CardType) IsEquip() bool       { return cardType.Has(TYPE_EQUIP) }
+func (cardType CardType) IsField() bool       { return cardType.Has(TYPE_FIELD) }
+func (cardType CardType) IsCounter() bool     { return cardType.Has(TYPE_COUNTER) }
+func (cardType CardType) IsFlip() bool        { return cardType.Has(TYPE_FLIP) }
+func (cardType CardType) IsToon() bool        { return cardType.Has(TYPE_TOON) }
+func (cardType CardType) IsXyz() bool         { return cardType.Has(TYPE_XYZ) }
+func (cardType CardType) IsPendulum() bool    { return cardType.Has(TYPE_PENDULUM) }
+func (cardType CardType) IsSpSummon() bool    { return cardType.Has(TYPE_SPSUMMON) }
+func (cardType CardType) IsLink() bool        { return cardType.Has(TYPE_LINK) }
+
+func (cardType CardType) IsEx() bool {
+	return cardType.Has(TYPE_FUSION | TYPE_SYNCHRO | TYPE_XYZ | TYPE_LINK)
+}
+
+// 卡片的大类：怪兽、魔法或陷阱
+func (cardType CardType) Category() CardType {
+	return cardType & (TYPE_MONSTER | TYPE_SPELL | TYPE_TRAP)
+}
+
+// 卡片种类的本地化描述，如 "Effect Fusion Monster"、"Continuous Trap"
+// 子类型按位从低到高排列，大类放在最后；特殊召唤标记不参与描述。
+func (card *Card) Kind() string {
+	return GetEnvironment(card.Locale).TypeKind(card.CardType())
+}
+
+func (environment *Environment) TypeKind(cardType CardType) string {
+	category := cardType.Category()
+	var names []string
+	for flag := TYPE_NORMAL; flag <= TYPE_LINK; flag <<= 1 {
+		if flag == TYPE_SPSUMMON || !cardType.Has(flag) {
+			continue
+		}
+		if text, exist := environment.typeText(flag); exist {
+			names = append(names, text)
+		}
+	}
+	if text, exist := environment.typeText(category); exist {
+		names = append(names, text)
+	}
+	return strings.Join(names, " ")
+}
+
+func (environment *Environment) typeText(flag CardType) (string, bool) {
+	for _, property := range environment.Types {
+		if property.value == int64(flag) {
+			return property.text, true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,137 @@
+package ygopro_data
+
+import (
+	"database/sql"
+	"fmt"
+	"net/url"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const ORPHAN_TEXT_SQL = "select texts.id from texts left join datas on texts.id == datas.id where datas.id is null"
+const ORPHAN_DATA_SQL = "select datas.id from datas left join texts on datas.id == texts.id where texts.id is null"
+
+// cdb 中的一个问题；Id 为 0 表示与具体卡片无关
+// Warning 表示仍然可以读取的情况，如缺少部分标准列的旧版 cdb
+type Issue struct {
+	Id      int
+	Message string
+	Warning bool
+}
+
+func (issue Issue) String() string {
+	message := issue.Message
+	if issue.Id != 0 {
+		message = fmt.Sprintf("card %v: %v", issue.Id, message)
+	}
+	if issue.Warning {
+		return "warning: " + message
+	}
+	return message
+}
+
+// 检查 cdb 的完整性：表结构、datas 与 texts 是否一一对应、同名卡的循环，
+// 以及每张卡片的位字段（与 CdbWriter 写入前的检查相同）
+func ValidateCdb(path string) []Issue {
+	db, err := sql.Open("sqlite3", readOnlyUri(path))
+	if err != nil {
+		return []Issue{{Message: err.Error()}}
+	}
+	defer db.Close()
+	version, selectSql, err := detectSchema(db)
+	if err != nil {
+		return []Issue{{Message: err.Error()}}
+	}
+	if version == CDB_SCHEMA_INVALID {
+		return []Issue{{Message: "missing datas or texts table"}}
+	}
+	var issues []Issue
+	if version == CDB_SCHEMA_LEGACY {
+		issues = append(issues, Issue{Message: "legacy schema: missing standard columns", Warning: true})
+	}
+	issues = append(issues, orphanIssues(db, ORPHAN_TEXT_SQL, "texts without datas")...)
+	issues = append(issues, orphanIssues(db, ORPHAN_DATA_SQL, "datas without texts")...)
+
+	rows, err := db.Query(selectSql)
+	if err != nil {
+		return append(issues, Issue{Message: err.Error()})
+	}
+	aliases := make(map[int]int)
+	for row := 1; rows.Next(); row++ {
+		card, err := createCardFromData("", rows)
+		if err != nil {
+			issues = append(issues, Issue{Message: fmt.Sprintf("row %v: %v", row, err)})
+			continue
+		}
+		for _, problem := range cardProblems(&card) {
+			issues = append(issues, Issue{Id: card.Id, Message: problem})
+		}
+		if card.Alias > 0 {
+			aliases[card.Id] = card.Alias
+		}
+	}
+	rows.Close()
+	return append(issues, aliasCycleIssues(aliases)...)
+}
+
+// 文件名中的 ?、#、% 需要转义；相对路径会被解析为 URI 的主机名，先转为绝对路径
+func readOnlyUri(path string) string {
+	if absolute, err := filepath.Abs(path); err == nil {
+		path = absolute
+	}
+	path = filepath.ToSlash(path)
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+	uri := url.URL{Scheme: "file", Path: path, RawQuery: "mode=ro"}
+	return uri.String()
+}
+
+func orphanIssues(db *sql.DB, query string, message string) []Issue {
+	rows, err := db.Query(query)
+	if err != nil {
+		return []Issue{{Message: err.Error()}}
+	}
+	defer rows.Close()
+	var issues []Issue
+	for rows.Next() {
+		var id int
+		rows.Scan(&id)
+		issues = append(issues, Issue{Id: id, Message: message})
+	}
+	return issues
+}
+
+// 每个循环只报告一次，记在循环中 id 最小的卡片上
+func aliasCycleIssues(aliases map[int]int) []Issue {
+	ids := make([]int, 0, len(aliases))
+	for id := range aliases {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	var issues []Issue
+	checked := make(map[int]bool)
+	for _, start := range ids {
+		path := make(map[int]bool)
+		for id := start; !checked[id]; id = aliases[id] {
+			if path[id] {
+				cycle := []int{id}
+				for next := aliases[id]; next != id; next = aliases[next] {
+					cycle = append(cycle, next)
+				}
+				sort.Ints(cycle)
+				issues = append(issues, Issue{Id: cycle[0], Message: fmt.Sprintf("alias cycle %v", cycle)})
+				break
+			}
+			path[id] = true
+			if _, exist := aliases[id]; !exist {
+				break
+			}
+		}
+		for id := range path {
+			checked[id] = true
+		}
+	}
+	return issues
+}
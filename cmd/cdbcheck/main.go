@@ -0,0 +1,41 @@
+// cdbcheck 检查 cdb 文件的完整性，发现问题时以状态码 1 退出；只有警告时不视为失败。
+//
+//	go run ./cmd/cdbcheck cards.cdb extra.cdb
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	ygopro_data "github.com/iamipanda/ygopro-data"
+)
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: cdbcheck file.cdb...\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	failed := false
+	for _, path := range flag.Args() {
+		if _, err := os.Stat(path); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			failed = true
+			continue
+		}
+		for _, issue := range ygopro_data.ValidateCdb(path) {
+			fmt.Printf("%v: %v\n", path, issue)
+			if !issue.Warning {
+				failed = true
+			}
+		}
+	}
+	if failed {
+		os.Exit(1)
+	}
+}
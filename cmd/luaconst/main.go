@@ -0,0 +1,219 @@
+// luaconst 读取 Constant.lua，将其中的常量分组生成带类型的 Go 常量。
+//
+//	go run ./cmd/luaconst -lua Constant.lua -out Constant.go
+//
+// 每个分组生成一个 int64 类型、对应的常量（Lua 注释保留为文档注释）、
+// 按小写短名查找的 <type>Values 表以及 String 方法。
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+type group struct {
+	prefix   string
+	typeName string
+	doc      string
+}
+
+// 需要生成的常量分组，前缀按最长匹配归类；多个前缀可以归入同一类型
+var groups = []group{
+	{"LOCATION_", "Location", "区域"},
+	{"LOCATION_REASON_", "LocationReason", "区域计数的原因"},
+	{"POS_", "Position", "表示形式"},
+	{"TYPE_", "CardType", "卡片类型"},
+	{"ATTRIBUTE_", "Attribute", "属性"},
+	{"RACE_", "Race", "种族"},
+	{"REASON_", "Reason", "卡片到当前位置的原因"},
+	{"SUMMON_TYPE_", "SummonType", "召唤类型"},
+	{"STATUS_", "Status", "卡片当前状态"},
+	{"ASSUME_", "Assume", "假定的卡片属性"},
+	{"COUNTER_", "CounterFlag", "指示物标记"},
+	{"PHASE_", "Phase", "阶段"},
+	{"PLAYER_", "Player", "玩家"},
+	{"CHAININFO_", "ChainInfo", "连锁信息"},
+	{"RESET_", "Reset", "重置条件"},
+	{"RESETS_", "Reset", "重置条件"},
+	{"EFFECT_TYPE_", "EffectType", "效果类型"},
+	{"EFFECT_FLAG_", "EffectFlag", "效果的特殊性质"},
+	{"EFFECT_FLAG2_", "EffectFlag2", "效果的特殊性质（第二组）"},
+	{"EFFECT_COUNT_CODE_", "CountCode", "发动次数限制的代码"},
+	{"EFFECT_", "EffectCode", "永续性效果的效果代码"},
+	{"EVENT_", "Event", "诱发效果的事件、时点"},
+	{"CATEGORY_", "Category", "效果分类"},
+	{"HINT_", "Hint", "提示类型"},
+	{"CHINT_", "CardHint", "卡片提示类型"},
+	{"OPCODE_", "Opcode", "操作码"},
+	{"HINTMSG_", "HintMessage", "提示消息"},
+	{"HINGMSG_", "HintMessage", "提示消息"},
+	{"SELECT_", "Select", "选择"},
+	{"TIMING_", "Timing", "提示时点"},
+	{"TIMINGS_", "Timing", "提示时点"},
+	{"GLOBALFLAG_", "GlobalFlag", "特殊标记"},
+	{"DUEL_", "DuelOption", "决斗选项"},
+	{"ACTIVITY_", "Activity", "活动计数器"},
+	{"ANNOUNCE_", "AnnounceType", "宣言类型"},
+}
+
+type constant struct {
+	name    string
+	short   string
+	literal string
+	value   int64
+	comment string
+}
+
+var lineRegex = regexp.MustCompile(`^([A-Z][A-Z0-9_]*)\s*=\s*(0x[0-9a-fA-F]+|\d+)\s*(?:--\s*(.*))?$`)
+
+func main() {
+	luaPath := flag.String("lua", "Constant.lua", "path of Constant.lua")
+	outPath := flag.String("out", "Constant.go", "path of generated go file")
+	packageName := flag.String("package", "ygopro_data", "package name of generated file")
+	flag.Parse()
+
+	file, err := os.Open(*luaPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer file.Close()
+	constants := make(map[string][]constant)
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if strings.HasPrefix(line, "--") {
+			continue
+		}
+		if c, ok := parseLine(line); ok {
+			if g, ok := findGroup(c.name); ok {
+				c.short = strings.ToLower(c.name[len(g.prefix):])
+				constants[g.typeName] = append(constants[g.typeName], c)
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		log.Fatal(err)
+	}
+
+	source, err := format.Source(generate(*packageName, constants))
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile(*outPath, source, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func parseLine(line string) (constant, bool) {
+	match := lineRegex.FindStringSubmatch(line)
+	if match == nil {
+		return constant{}, false
+	}
+	value, err := strconv.ParseInt(match[2], 0, 64)
+	if err != nil {
+		return constant{}, false
+	}
+	comment := strings.TrimSpace(strings.TrimLeft(match[3], "-"))
+	return constant{name: match[1], literal: match[2], value: value, comment: comment}, true
+}
+
+func findGroup(name string) (group, bool) {
+	found := false
+	var answer group
+	for _, g := range groups {
+		if strings.HasPrefix(name, g.prefix) && (!found || len(g.prefix) > len(answer.prefix)) {
+			answer = g
+			found = true
+		}
+	}
+	return answer, found
+}
+
+func generate(packageName string, constants map[string][]constant) []byte {
+	var buffer bytes.Buffer
+	fmt.Fprintf(&buffer, "// Code generated by luaconst from Constant.lua; DO NOT EDIT.\n\n")
+	fmt.Fprintf(&buffer, "package %v\n", packageName)
+	buffer.WriteString(helperSource)
+	generated := make(map[string]bool)
+	for _, g := range groups {
+		if generated[g.typeName] {
+			continue
+		}
+		generated[g.typeName] = true
+		generateGroup(&buffer, g, constants[g.typeName])
+	}
+	return buffer.Bytes()
+}
+
+func generateGroup(buffer *bytes.Buffer, g group, constants []constant) {
+	variable := lowerFirst(g.typeName)
+	fmt.Fprintf(buffer, "\n// %v %v\ntype %v int64\n\nconst (\n", g.typeName, g.doc, g.typeName)
+	for _, c := range constants {
+		if len(c.comment) > 0 {
+			fmt.Fprintf(buffer, "\t// %v\n", c.comment)
+		}
+		fmt.Fprintf(buffer, "\t%v %v = %v\n", c.name, g.typeName, c.literal)
+	}
+	buffer.WriteString(")\n")
+
+	fmt.Fprintf(buffer, "\nvar %vValues = map[string]%v{\n", variable, g.typeName)
+	for _, c := range constants {
+		fmt.Fprintf(buffer, "\t%q: %v,\n", c.short, c.name)
+	}
+	buffer.WriteString("}\n")
+
+	fmt.Fprintf(buffer, "\nvar %vNames = []constantName{\n", variable)
+	for _, c := range constants {
+		fmt.Fprintf(buffer, "\t{%v, %q},\n", c.literal, c.name)
+	}
+	buffer.WriteString("}\n")
+
+	fmt.Fprintf(buffer, "\nfunc (value %v) String() string {\n", g.typeName)
+	fmt.Fprintf(buffer, "\treturn constantString(int64(value), %vNames, %q)\n}\n", variable, g.typeName)
+}
+
+func lowerFirst(name string) string {
+	return strings.ToLower(name[:1]) + name[1:]
+}
+
+// 生成文件中共用的名称查找逻辑：优先完全匹配，否则按单个位拆分
+const helperSource = `
+import (
+	"fmt"
+	"strings"
+)
+
+type constantName struct {
+	value int64
+	name  string
+}
+
+func constantString(value int64, names []constantName, typeName string) string {
+	for _, constant := range names {
+		if constant.value == value {
+			return constant.name
+		}
+	}
+	rest := value
+	var parts []string
+	for _, constant := range names {
+		if constant.value > 0 && constant.value&(constant.value-1) == 0 && rest&constant.value > 0 {
+			parts = append(parts, constant.name)
+			rest &^= constant.value
+		}
+	}
+	if len(parts) == 0 || rest != 0 {
+		return fmt.Sprintf("%v(0x%x)", typeName, value)
+	}
+	return strings.Join(parts, "|")
+}
+`
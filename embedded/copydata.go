@@ -0,0 +1,45 @@
+//go:build ignore
+
+// 将仓库中的 Constant.lua 与 ygopro-database/locales 复制到 data 目录，供 go:embed 使用
+package main
+
+import (
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+func main() {
+	os.RemoveAll("data")
+	copyFile(filepath.Join("..", "Constant.lua"), filepath.Join("data", "Constant.lua"))
+	root := filepath.Join("..", "ygopro-database", "locales")
+	err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		if name := info.Name(); name != "strings.conf" && !strings.HasSuffix(name, ".cdb") {
+			return nil
+		}
+		relative, _ := filepath.Rel(root, path)
+		copyFile(path, filepath.Join("data", "locales", relative))
+		return nil
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+func copyFile(source string, target string) {
+	bytes, err := ioutil.ReadFile(source)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile(target, bytes, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
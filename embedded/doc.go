@@ -0,0 +1,24 @@
+// Package embedded 将 Constant.lua、strings.conf 与 cdb 打包进二进制文件。
+//
+// 数据需要先复制到 embedded/data，再以 ygopro_embed 标签编译：
+//
+//	go generate ./embedded
+//	go build -tags ygopro_embed
+//
+// 导入该包后，GetEnvironment 直接从内嵌的数据创建环境：
+//
+//	import _ "github.com/iamipanda/ygopro-data/embedded"
+//
+// cdb 在启动时反序列化为内存数据库，运行时不会在磁盘上写入任何文件。
+//
+// go-sqlite3 依赖 cgo，编译时需要 CGO_ENABLED=1 和 C 编译器；
+// 要得到不依赖动态库的静态二进制文件，还需要静态链接 libc，例如：
+//
+//	CGO_ENABLED=1 go build -tags "ygopro_embed sqlite_omit_load_extension" -ldflags '-linkmode external -extldflags "-static"'
+//
+// sqlite_omit_load_extension 去掉 SQLite 的扩展加载，避免 glibc 静态链接 dlopen 时的警告。
+//
+// 不带 ygopro_embed 标签时该包为空。
+package embedded
+
+//go:generate go run copydata.go
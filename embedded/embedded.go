@@ -0,0 +1,26 @@
+//go:build ygopro_embed
+
+package embedded
+
+import (
+	"embed"
+
+	"github.com/iamipanda/ygopro-data"
+)
+
+//go:embed data
+var Data embed.FS
+
+func Options() ygopro_data.EnvironmentOptions {
+	return ygopro_data.EnvironmentOptions{
+		FS:           Data,
+		DatabasePath: "data/locales",
+		LuaPath:      "data/Constant.lua",
+	}
+}
+
+func init() {
+	if err := ygopro_data.Initialize(Options()); err != nil {
+		panic(err)
+	}
+}